@@ -0,0 +1,212 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"path"
+	"strings"
+	"time"
+)
+
+// 本地链的创世时间和出块间隔，用于从当前时间推算区块高度，保证会话内高度单调递增
+var (
+	chainGenesis  = time.Date(2020, 12, 1, 12, 0, 23, 0, time.UTC)
+	chainSlotTime = 12 * time.Second
+)
+
+// 本地开发链的 chain id，只在本机 devnet 上部署
+const devnetChainID = 31337
+
+// 合约及其对外函数，函数名用于 gas 报告
+var contractFunctions = map[string][]string{
+	"Vault":       {"deposit", "withdraw", "redeem", "previewRedeem", "totalAssets"},
+	"Governor":    {"propose", "castVote", "queue", "execute", "state"},
+	"StakingPool": {"stake", "unstake", "claimRewards", "earned", "notifyRewardAmount"},
+}
+
+// randomAddress 生成一个 20 字节的十六进制地址
+func randomAddress() string {
+	return "0x" + randomHex(40)
+}
+
+// randomTxHash 生成一个 32 字节的交易哈希
+func randomTxHash() string {
+	return "0x" + randomHex(64)
+}
+
+// shortHash 按节点日志的习惯缩写哈希，如 0x3f1a..9c2e
+func shortHash(h string) string {
+	return h[:6] + ".." + h[len(h)-4:]
+}
+
+// chainHead 根据当前时间推算链头高度
+func chainHead() int {
+	return int(time.Since(chainGenesis) / chainSlotTime)
+}
+
+// nodeLog 按 tracing 的格式打印一行节点日志
+func nodeLog(level, target, message string, fields ...string) {
+	ts := time.Now().UTC().Format("2006-01-02T15:04:05.000000Z")
+	lvl := green(" INFO")
+	switch level {
+	case "WARN":
+		lvl = yellow(" WARN")
+	case "DEBUG":
+		lvl = blue("DEBUG")
+	}
+	line := fmt.Sprintf("%s %s %s: %s", ts, lvl, target, message)
+	if len(fields) > 0 {
+		line += " " + strings.Join(fields, " ")
+	}
+	fmt.Println(line)
+	time.Sleep(time.Duration(rand.Intn(180)+60) * time.Millisecond)
+}
+
+// runNodeSync 模拟本地节点同步区块、维护对等节点和内存池
+func runNodeSync(config *SessionConfig) {
+	crate := config.naming.slug
+	node := config.naming.name + "-node"
+	fmt.Println(blue("⛓️  " + tr("chain.syncTitle", node)))
+
+	head := chainHead()
+	local := head - rand.Intn(40) - 8
+	peers := rand.Intn(30) + 20
+
+	nodeLog("INFO", crate+"_p2p::network", "Network status", fmt.Sprintf("connected_peers=%d", peers),
+		fmt.Sprintf("inbound=%d", peers/3), fmt.Sprintf("outbound=%d", peers-peers/3))
+	nodeLog("INFO", crate+"_consensus::sync", "Syncing",
+		fmt.Sprintf("local_head=%d", local), fmt.Sprintf("network_head=%d", head),
+		fmt.Sprintf("distance=%d", head-local))
+
+	for local < head {
+		batch := min(head-local, rand.Intn(12)+4)
+		local += batch
+		txs := rand.Intn(250) + 40
+		gas := txs*(rand.Intn(60000)+60000) + rand.Intn(50000)
+		nodeLog("INFO", crate+"_consensus::import", "Imported new chain segment",
+			fmt.Sprintf("number=%d", local), fmt.Sprintf("hash=%s", shortHash(randomTxHash())),
+			fmt.Sprintf("blocks=%d", batch), fmt.Sprintf("txs=%d", txs), fmt.Sprintf("mgas=%.2f", float64(gas)/1e6),
+			fmt.Sprintf("elapsed=%.1fms", rand.Float64()*80+15))
+		if rand.Float32() < 0.2 {
+			nodeLog("WARN", crate+"_p2p::peer", "Peer disconnected, score below threshold",
+				fmt.Sprintf("peer_id=16Uiu2HAm%s", randomHex(8)), fmt.Sprintf("score=%.1f", -(rand.Float64()*40+20)))
+			peers--
+		}
+	}
+
+	// 以太坊的最终性大约落后链头两个 epoch
+	epoch := head / 32
+	finalized := (epoch - 2) * 32
+	nodeLog("INFO", crate+"_consensus::fork_choice", "Finalized checkpoint updated",
+		fmt.Sprintf("epoch=%d", epoch-2), fmt.Sprintf("block=%d", finalized),
+		fmt.Sprintf("root=%s", shortHash(randomTxHash())))
+	nodeLog("INFO", crate+"_consensus::sync", "Fully synced", fmt.Sprintf("head=%d", head),
+		fmt.Sprintf("finalized=%d", finalized), fmt.Sprintf("peers=%d", peers))
+
+	// 内存池
+	pending, queued := rand.Intn(5000)+800, rand.Intn(600)+20
+	baseFee := rand.Float64()*25 + 4
+	fmt.Printf("\n📥 %s\n", tr("chain.mempool", formatInt(pending), formatInt(queued), formatFloat(baseFee, 2)))
+	fmt.Printf("  %-18s %-16s %-16s %10s %12s\n", "TX HASH", "FROM", "TO", "GAS", "TIP (gwei)")
+	txs := rand.Intn(3) + 4
+	for i := 0; i < txs; i++ {
+		to := shortHash(randomAddress())
+		if rand.Float32() < 0.15 {
+			to = yellow(fmt.Sprintf("%-16s", "(create)"))
+		}
+		fmt.Printf("  %-18s %-16s %-16s %10d %12.2f\n", shortHash(randomTxHash()), shortHash(randomAddress()), to,
+			21000+rand.Intn(40)*5000, rand.Float64()*3+0.05)
+		time.Sleep(time.Duration(rand.Intn(120)+40) * time.Millisecond)
+	}
+
+	fmt.Printf("✅ %s\n", tr("chain.synced", formatInt(head), formatInt(finalized), peers))
+	if rand.Float32() < jargonDensity(config.jargonLevel) {
+		fmt.Printf("🧠 %s\n", tr("jargon.insight", generateNetworkJargon(config.devType, config.jargonLevel)))
+	}
+}
+
+// repoContracts 返回虚拟仓库中的合约名，没有合约时使用默认集合
+func repoContracts(repo *FakeRepo) []string {
+	var names []string
+	for _, f := range repo.sourceFiles() {
+		if f.language == "solidity" {
+			names = append(names, strings.TrimSuffix(path.Base(f.path), ".sol"))
+		}
+	}
+	if len(names) == 0 {
+		names = []string{"Vault", "Governor", "StakingPool"}
+	}
+	return names
+}
+
+// runContractDeploy 模拟在本地 devnet 上编译、测试和部署合约
+func runContractDeploy(config *SessionConfig) {
+	contracts := repoContracts(config.repo)
+	fmt.Println(blue("📜 " + tr("chain.deployTitle", len(contracts), devnetChainID)))
+
+	fmt.Println("$ forge build")
+	files := len(contracts)*3 + rand.Intn(30) + 20
+	fmt.Println("[⠊] Compiling...")
+	time.Sleep(time.Duration(rand.Intn(300)+200) * time.Millisecond)
+	fmt.Printf("[⠒] Compiling %d files with Solc 0.8.26\n", files)
+	time.Sleep(time.Duration(rand.Intn(500)+300) * time.Millisecond)
+	fmt.Printf("[⠢] Solc 0.8.26 finished in %.2fs\n", rand.Float64()*4+1)
+	fmt.Println(green("Compiler run successful!"))
+
+	// gas 报告
+	fmt.Println("\n$ forge test --gas-report")
+	type deployment struct {
+		name       string
+		cost, size int
+	}
+	var deployed []deployment
+	for _, c := range contracts {
+		cost := rand.Intn(1800000) + 700000
+		size := cost/200 + rand.Intn(400)
+		deployed = append(deployed, deployment{c, cost, size})
+		title := fmt.Sprintf("contracts/src/%s.sol:%s contract", c, c)
+		width := max(len(title), 24)
+		sep := fmt.Sprintf("|-%s-+-%s-+-%s-+-%s-+-%s-+-%s-|", strings.Repeat("-", width),
+			strings.Repeat("-", 15), strings.Repeat("-", 6), strings.Repeat("-", 6), strings.Repeat("-", 6), strings.Repeat("-", 7))
+		row := func(cols ...string) {
+			fmt.Printf("| %-*s | %-15s | %-6s | %-6s | %-6s | %-7s |\n", width, cols[0], cols[1], cols[2], cols[3], cols[4], cols[5])
+		}
+		row(title, "", "", "", "", "")
+		fmt.Println(sep)
+		row("Deployment Cost", "Deployment Size", "", "", "", "")
+		row(fmt.Sprint(cost), fmt.Sprint(size), "", "", "", "")
+		row("Function Name", "min", "avg", "median", "max", "# calls")
+		functions := contractFunctions[c]
+		if functions == nil {
+			functions = []string{"initialize", "execute", "owner"}
+		}
+		for _, fn := range functions {
+			lo := rand.Intn(30000) + 2400
+			hi := lo + rand.Intn(80000)
+			avg := lo + (hi-lo)*(rand.Intn(40)+30)/100
+			median := lo + (hi-lo)*(rand.Intn(40)+20)/100
+			row(fn, fmt.Sprint(lo), fmt.Sprint(avg), fmt.Sprint(median), fmt.Sprint(hi), fmt.Sprint(rand.Intn(40)+1))
+		}
+		fmt.Println()
+		time.Sleep(time.Duration(rand.Intn(200)+100) * time.Millisecond)
+	}
+
+	// 部署到本地 anvil
+	fmt.Printf("$ forge script script/Deploy.s.sol --rpc-url http://127.0.0.1:8545 --broadcast\n")
+	fmt.Printf("##### anvil-hardhat\n")
+	block := rand.Intn(40) + 2
+	totalGas := 0
+	gasPrice := rand.Float64()*2 + 0.8
+	for _, d := range deployed {
+		time.Sleep(time.Duration(rand.Intn(300)+150) * time.Millisecond)
+		fmt.Println(green("✅  [Success] ") + "Hash: " + randomTxHash())
+		fmt.Printf("Contract Address: %s\n", randomAddress())
+		fmt.Printf("Block: %d\n", block)
+		fmt.Printf("Paid: %.9f ETH (%d gas * %.9f gwei)\n\n", float64(d.cost)*gasPrice/1e9, d.cost, gasPrice)
+		totalGas += d.cost
+		block++
+	}
+	fmt.Printf("✅ Sequence #1 on anvil-hardhat | Total Paid: %.9f ETH (%d gas * avg %.9f gwei)\n",
+		float64(totalGas)*gasPrice/1e9, totalGas, gasPrice)
+	fmt.Printf("🚀 %s\n", tr("chain.deployed", len(deployed), formatInt(totalGas)))
+}
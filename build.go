@@ -0,0 +1,348 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"path"
+	"strings"
+	"time"
+)
+
+// buildStats 一次模拟编译的统计
+type buildStats struct {
+	compiled int
+	cached   int
+	warnings int
+	elapsed  float64 // 模拟耗时（秒）
+}
+
+// buildToolchain 根据仓库语言、开发类型和框架选择构建工具
+func buildToolchain(config *SessionConfig) string {
+	fw := strings.ToLower(config.framework)
+	switch config.repo.language {
+	case "rust":
+		return "cargo"
+	case "typescript", "vue":
+		if strings.Contains(fw, "webpack") || strings.Contains(fw, "angular") ||
+			(fw == "" && config.devType == Fullstack) {
+			return "webpack"
+		}
+		return "vite"
+	case "java":
+		return "gradle"
+	case "cpp":
+		return "cmake"
+	case "python":
+		return "python -m build"
+	}
+	return "go"
+}
+
+// runBuild 以会话对应工具链的风格模拟一次编译
+func runBuild(config *SessionConfig) {
+	toolchain := buildToolchain(config)
+	fmt.Println(blue("🔨 " + tr("build.title", toolchain)))
+
+	var stats buildStats
+	switch toolchain {
+	case "cargo":
+		buildCargo(config, &stats)
+	case "vite":
+		buildVite(config, &stats)
+	case "webpack":
+		buildWebpack(config, &stats)
+	case "gradle":
+		buildGradle(config, &stats)
+	case "cmake":
+		buildCMake(config, &stats)
+	case "python -m build":
+		buildPython(config, &stats)
+	default:
+		buildGo(config, &stats)
+	}
+
+	fmt.Printf("\n✅ %s\n", tr("build.summary", formatFloat(stats.elapsed, 2),
+		trn("build.compiled", stats.compiled), trn("build.cached", stats.cached), trn("build.warnings", stats.warnings)))
+	if rand.Float32() < jargonDensity(config.jargonLevel) {
+		fmt.Printf("🧠 %s\n", tr("jargon.insight", generatePerformanceJargon(config.devType, config.jargonLevel)))
+	}
+}
+
+// buildStep 输出一行构建日志并推进模拟时间
+func buildStep(stats *buildStats, line string, seconds float64) {
+	fmt.Println(line)
+	stats.elapsed += seconds
+	time.Sleep(time.Duration(rand.Intn(120)+40) * time.Millisecond)
+}
+
+func buildGo(config *SessionConfig, stats *buildStats) {
+	fmt.Println("$ go build -v ./...")
+	// go build -v 只打印需要重新编译的包
+	for _, dep := range runtimeDeps("go") {
+		if rand.Float32() < 0.75 {
+			stats.cached++
+			continue
+		}
+		stats.compiled++
+		buildStep(stats, dep.name, rand.Float64()*0.8+0.1)
+	}
+	mainPkg := ""
+	for _, dir := range config.repo.dirs {
+		if strings.HasPrefix(dir, "cmd/") {
+			mainPkg = dir
+			continue
+		}
+		if rand.Float32() < 0.35 {
+			stats.cached++
+			continue
+		}
+		stats.compiled++
+		buildStep(stats, config.naming.modulePath+"/"+dir, rand.Float64()*0.6+0.05)
+	}
+	stats.compiled++
+	buildStep(stats, config.naming.modulePath+"/"+mainPkg, rand.Float64()*1.5+0.5)
+	if rand.Float32() < 0.15 {
+		stats.warnings++
+		fmt.Println("# " + config.naming.modulePath + "/" + mainPkg)
+		fmt.Println(yellow("ld: warning: ignoring duplicate libraries: '-lresolv'"))
+	}
+}
+
+func buildCargo(config *SessionConfig, stats *buildStats) {
+	fmt.Println("$ cargo build --release")
+	for _, dep := range runtimeDeps("rust") {
+		if rand.Float32() < 0.6 {
+			stats.cached++
+			continue
+		}
+		stats.compiled++
+		buildStep(stats, fmt.Sprintf("%s %s v%s", green(fmt.Sprintf("%12s", "Compiling")), dep.name, dep.version), rand.Float64()*3+0.3)
+	}
+
+	crates := map[string]bool{}
+	var order []string
+	for _, dir := range config.repo.dirs {
+		// crates/<crate>/src -> <crate>
+		parts := strings.Split(dir, "/")
+		if len(parts) >= 2 && parts[0] == "crates" && !crates[parts[1]] {
+			crates[parts[1]] = true
+			order = append(order, parts[1])
+		}
+	}
+	for _, crate := range order {
+		stats.compiled++
+		buildStep(stats, fmt.Sprintf("%s %s v%s (%s/crates/%s)", green(fmt.Sprintf("%12s", "Compiling")), crate, config.naming.version, config.naming.repoDir, crate),
+			rand.Float64()*6+1)
+		if rand.Float32() < 0.15 {
+			stats.warnings++
+			files := config.repo.filesIn("crates/" + crate + "/src")
+			file := files[rand.Intn(len(files))]
+			line := rand.Intn(file.lines) + 1
+			// rustc 的行号栏宽度与行号位数一致
+			gutter := strings.Repeat(" ", len(fmt.Sprint(line)))
+			fmt.Println(yellow("warning") + ": unused import: `std::collections::HashMap`")
+			fmt.Printf("%s%s %s:%d:5\n", gutter, blue("-->"), file.path, line)
+			fmt.Printf("%s %s\n", gutter, blue("|"))
+			fmt.Printf("%s use std::collections::HashMap;\n", blue(fmt.Sprintf("%d |", line)))
+			fmt.Printf("%s %s     %s\n", gutter, blue("|"), yellow("^^^^^^^^^^^^^^^^^^^^^^^^"))
+			fmt.Printf("%s %s\n", gutter, blue("|"))
+			fmt.Printf("%s %s %s: `#[warn(unused_imports)]` on by default\n\n", gutter, blue("="), "note")
+			fmt.Printf("%s: `%s` (lib) generated 1 warning\n", yellow("warning"), crate)
+		}
+	}
+	buildStep(stats, fmt.Sprintf("%s release [optimized] target(s) in %.2fs", green(fmt.Sprintf("%12s", "Finished")), stats.elapsed), 0)
+}
+
+func buildVite(config *SessionConfig, stats *buildStats) {
+	fmt.Println("$ npm run build")
+	fmt.Println()
+	typeCheck := "tsc -b"
+	if config.repo.language == "vue" {
+		typeCheck = "vue-tsc --noEmit"
+	}
+	fmt.Printf("> %s@%s build\n> %s && vite build\n\n", config.naming.name, config.naming.version, typeCheck)
+	fmt.Printf("%s %s\n", blue("vite v5.3.5"), green("building for production..."))
+
+	modules := len(config.repo.sourceFiles())*8 + rand.Intn(900) + 300
+	stats.cached = rand.Intn(modules / 2)
+	stats.compiled = modules - stats.cached
+	buildStep(stats, fmt.Sprintf("transforming (%d) %s", modules/3, config.repo.randomFile().path), rand.Float64()+0.5)
+	buildStep(stats, green("✓")+fmt.Sprintf(" %d modules transformed.", modules), rand.Float64()*2+1)
+	buildStep(stats, "rendering chunks...", 0.4)
+	buildStep(stats, "computing gzip size...", 0.2)
+
+	type chunk struct {
+		name string
+		kb   float64
+	}
+	chunks := []chunk{{"index.html", 0.46}, {"assets/index-" + randomAssetHash() + ".css", rand.Float64()*30 + 8}}
+	for _, f := range config.repo.sampleFiles(rand.Intn(3) + 2) {
+		name := strings.TrimSuffix(path.Base(f.path), path.Ext(f.path))
+		chunks = append(chunks, chunk{"assets/" + name + "-" + randomAssetHash() + ".js", float64(f.lines) * (rand.Float64()*0.1 + 0.05)})
+	}
+	chunks = append(chunks, chunk{"assets/index-" + randomAssetHash() + ".js", rand.Float64()*400 + 180})
+	for _, c := range chunks {
+		name := "dist/" + c.name
+		if strings.HasSuffix(c.name, ".js") {
+			name = blue(fmt.Sprintf("%-48s", name))
+		} else {
+			name = fmt.Sprintf("%-48s", name)
+		}
+		fmt.Printf("%s %9.2f kB │ gzip: %7.2f kB\n", name, c.kb, c.kb*0.31)
+		if c.kb > 500 {
+			stats.warnings++
+		}
+	}
+	if stats.warnings > 0 {
+		fmt.Println(yellow("\n(!) Some chunks are larger than 500 kB after minification. Consider:\n- Using dynamic import() to code-split the application"))
+	}
+	buildStep(stats, green("✓ built in ")+fmt.Sprintf("%.2fs", stats.elapsed), 0)
+}
+
+func buildWebpack(config *SessionConfig, stats *buildStats) {
+	fmt.Println("$ npx webpack --mode production")
+	sources := config.repo.sourceFiles()
+	modules := len(sources)*6 + rand.Intn(400)
+	stats.cached = rand.Intn(modules)
+	stats.compiled = modules - stats.cached
+	buildStep(stats, fmt.Sprintf("<i> [webpack.cache.PackFileCacheStrategy] restored %d modules from cache", stats.cached), rand.Float64()+0.4)
+
+	mainKiB := rand.Intn(300) + 180
+	fmt.Printf("asset main.%s.js %d KiB [emitted] [immutable] [minimized]%s (name: main) 1 related asset\n",
+		randomHex(8), mainKiB, bigMarker(mainKiB))
+	for _, f := range config.repo.sampleFiles(rand.Intn(3) + 1) {
+		kib := f.lines/8 + rand.Intn(20)
+		fmt.Printf("asset %s.%s.js %d KiB [emitted] [immutable] [minimized]%s 1 related asset\n",
+			strings.ToLower(strings.TrimSuffix(path.Base(f.path), path.Ext(f.path))), randomHex(8), kib, bigMarker(kib))
+	}
+	fmt.Printf("asset main.%s.css %d KiB [emitted] [immutable] (name: main)\n", randomHex(8), rand.Intn(40)+5)
+	fmt.Printf("orphan modules %d KiB [orphan] %d modules\n", rand.Intn(500)+100, rand.Intn(200)+40)
+	fmt.Printf("runtime modules %.1f KiB 12 modules\n", rand.Float64()*5+3)
+	fmt.Printf("cacheable modules %.2f MiB\n", float64(modules)*0.004)
+	stats.elapsed += rand.Float64()*10 + 4
+
+	if mainKiB > 244 {
+		stats.warnings += 2
+		fmt.Println(yellow("\nWARNING in asset size limit: The following asset(s) exceed the recommended size limit (244 KiB)."))
+		fmt.Println(yellow("WARNING in entrypoint size limit: The following entrypoint(s) combined asset size exceeds the recommended limit (244 KiB)."))
+	}
+	summary := "compiled successfully"
+	if stats.warnings > 0 {
+		summary = yellow(fmt.Sprintf("compiled with %d warnings", stats.warnings))
+	} else {
+		summary = green(summary)
+	}
+	buildStep(stats, fmt.Sprintf("\nwebpack 5.93.0 %s in %d ms", summary, int(stats.elapsed*1000)), 0)
+}
+
+// bigMarker 超过 webpack 推荐体积时追加 [big] 标记
+func bigMarker(kib int) string {
+	if kib > 244 {
+		return " " + yellow("[big]")
+	}
+	return ""
+}
+
+func buildGradle(config *SessionConfig, stats *buildStats) {
+	fmt.Println("$ ./gradlew build -x test")
+	tasks := []string{
+		":compileJava", ":processResources", ":classes", ":resolveMainClassName",
+		":bootJar", ":jar", ":assemble", ":checkstyleMain", ":pmdMain", ":spotbugsMain", ":check", ":build",
+	}
+	executed := 0
+	for _, task := range tasks {
+		state := ""
+		if task != ":compileJava" && rand.Float32() < 0.35 {
+			state = " UP-TO-DATE"
+			stats.cached++
+		} else if rand.Float32() < 0.1 {
+			state = " FROM-CACHE"
+			stats.cached++
+		} else {
+			executed++
+		}
+		buildStep(stats, "> Task "+task+state, rand.Float64()*2+0.2)
+		if task == ":compileJava" {
+			stats.compiled = len(config.repo.sourceFiles())
+			if rand.Float32() < 0.3 {
+				stats.warnings++
+				fmt.Println("Note: Some input files use unchecked or unsafe operations.")
+				fmt.Println("Note: Recompile with -Xlint:unchecked for details.")
+			}
+		}
+	}
+	fmt.Println()
+	fmt.Println(green(fmt.Sprintf("BUILD SUCCESSFUL in %ds", int(stats.elapsed)+1)))
+	fmt.Printf("%d actionable tasks: %d executed, %d up-to-date\n", len(tasks), executed, len(tasks)-executed)
+}
+
+func buildCMake(config *SessionConfig, stats *buildStats) {
+	fmt.Println("$ cmake --build build --config Release -j 16")
+	var sources []RepoFile
+	for _, f := range config.repo.files {
+		if strings.HasSuffix(f.path, ".cpp") {
+			sources = append(sources, f)
+		}
+	}
+	total := len(sources) + 1
+	step := 0
+	for _, f := range sources {
+		step++
+		if rand.Float32() < 0.4 {
+			// ccache 命中时 ninja 仍然会打印这一步，只是几乎不耗时
+			stats.cached++
+			buildStep(stats, fmt.Sprintf("[%d/%d] Building CXX object %s.o", step, total, objectPath(f.path)), 0.01)
+			continue
+		}
+		stats.compiled++
+		buildStep(stats, fmt.Sprintf("[%d/%d] Building CXX object %s.o", step, total, objectPath(f.path)), rand.Float64()*4+0.5)
+		if rand.Float32() < 0.08 {
+			stats.warnings++
+			fmt.Printf("%s:%d:12: %s unused variable 'deltaSeconds' [-Wunused-variable]\n", f.path, rand.Intn(f.lines)+1, yellow("warning:"))
+		}
+	}
+	buildStep(stats, fmt.Sprintf("[%d/%d] Linking CXX executable %s", total, total, pascalCase(config.naming.name)), rand.Float64()*5+2)
+	fmt.Printf("ccache: %d hits, %d misses\n", stats.cached, stats.compiled)
+}
+
+// objectPath 返回源文件在 CMake 构建目录中的目标文件路径
+func objectPath(src string) string {
+	dir := path.Dir(src)
+	target := strings.Split(dir, "/")[1]
+	return fmt.Sprintf("%s/CMakeFiles/%s.dir/%s", dir, target, path.Base(src))
+}
+
+func buildPython(config *SessionConfig, stats *buildStats) {
+	fmt.Println("$ python -m build")
+	dist := config.naming.slug + "-" + config.naming.version
+	buildStep(stats, "* Creating isolated environment: venv+pip...", 1.2)
+	buildStep(stats, "* Installing packages in isolated environment:\n  - setuptools >= 69\n  - wheel", 3.1)
+	buildStep(stats, "* Getting build dependencies for sdist...", 0.3)
+	buildStep(stats, "running egg_info", 0.2)
+	buildStep(stats, fmt.Sprintf("writing %s.egg-info/PKG-INFO", config.naming.slug), 0.1)
+	if rand.Float32() < 0.3 {
+		stats.warnings++
+		fmt.Println(yellow("SetuptoolsDeprecationWarning: License classifiers are deprecated."))
+	}
+	buildStep(stats, "* Building wheel from sdist", 0.5)
+	buildStep(stats, "running bdist_wheel", 0.2)
+	for _, f := range config.repo.sourceFiles() {
+		if rand.Float32() < 0.5 {
+			stats.cached++
+			continue
+		}
+		stats.compiled++
+		buildStep(stats, fmt.Sprintf("copying %s -> build/lib/%s", f.path, f.dir), 0.02)
+	}
+	buildStep(stats, fmt.Sprintf("Successfully built %s.tar.gz and %s-py3-none-any.whl", green(dist), green(dist)), 0.4)
+}
+
+// randomAssetHash 生成 vite 风格的 8 位资源哈希
+func randomAssetHash() string {
+	const chars = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789_-"
+	b := make([]byte, 8)
+	for i := range b {
+		b[i] = chars[rand.Intn(len(chars))]
+	}
+	return string(b)
+}
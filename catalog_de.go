@@ -0,0 +1,280 @@
+package main
+
+// 德语消息目录
+var catalogDE = map[string]string{
+	// 启动与退出
+	"boot.init":          "System wird initialisiert...",
+	"boot.config":        "Konfiguration wird geladen...",
+	"boot.project":       "Projekt: %s",
+	"boot.module":        "Modul: %s",
+	"boot.namespace":     "Namespace: %s",
+	"boot.workspace":     "Arbeitsverzeichnis: %s",
+	"boot.framework":     "Framework: %s",
+	"session.terminated": "Sitzung beendet.",
+
+	// 代码分析
+	"analysis.title":          "Codeanalyse läuft%s",
+	"analysis.progress":       "Dateien werden analysiert...",
+	"analysis.complete":       "Analyse abgeschlossen: %s, %s",
+	"analysis.files#one":      "%s Datei",
+	"analysis.files#other":    "%s Dateien",
+	"analysis.lines#one":      "%s Codezeile",
+	"analysis.lines#other":    "%s Codezeilen",
+	"analysis.issues":         "Gefundene Probleme: %s (%s, %s, %s)",
+	"analysis.errors#one":     "%s Fehler",
+	"analysis.errors#other":   "%s Fehler",
+	"analysis.warnings#one":   "%s Warnung",
+	"analysis.warnings#other": "%s Warnungen",
+	"analysis.notes#one":      "%s Hinweis",
+	"analysis.notes#other":    "%s Hinweise",
+	"analysis.fixable#one":    "Für %s Problem gibt es einen Korrekturvorschlag",
+	"analysis.fixable#other":  "Für %s Probleme gibt es Korrekturvorschläge",
+	"analysis.quality":        "Codequalität: %s",
+	"analysis.debt":           "Technische Schulden: %s",
+	"analysis.fix":            "Korrektur: %s",
+
+	// 代码分析的问题类别
+	"analysis.issue.memoryLeak":   "Mögliches Speicherleck",
+	"analysis.issue.uncaught":     "Nicht abgefangene Ausnahme",
+	"analysis.issue.resource":     "Ressource nicht freigegeben",
+	"analysis.issue.inefficient":  "Ineffizienter Algorithmus",
+	"analysis.issue.security":     "Sicherheitslücke",
+	"analysis.issue.maintainable": "Wartbarkeit",
+
+	// 性能分析
+	"perf.title":          "Leistungsanalyse",
+	"perf.progress":       "Metriken werden gesammelt...",
+	"perf.results":        "Leistungsergebnisse:",
+	"perf.average":        "Durchschnitt: %s ms",
+	"perf.median":         "Median: %s ms",
+	"perf.p95":            "P95: %s ms",
+	"perf.p99":            "P99: %s ms",
+	"perf.recommendation": "Empfehlung: %s",
+
+	// 系统监控
+	"monitor.title":      "Überwachung der Systemressourcen",
+	"monitor.progress":   "Überwachung läuft...",
+	"monitor.line":       "CPU: %s  |  RAM: %s  |  Netzwerk: %s MB/s  |  Festplatten-I/O: %s MB/s  |  Prozesse: %s",
+	"monitor.summary":    "Zusammenfassung der Ressourcennutzung:",
+	"monitor.peakCPU":    "CPU-Spitze: %s",
+	"monitor.peakMemory": "Speicher-Spitze: %s",
+	"monitor.network":    "Netzwerkdurchsatz: %s MB/s",
+	"monitor.disk":       "Festplattendurchsatz: %s MB/s",
+
+	// 数据处理
+	"data.title":           "Datenströme werden verarbeitet",
+	"data.progress":        "Daten werden verarbeitet...",
+	"data.processed#one":   "%s Datenpunkt verarbeitet",
+	"data.processed#other": "%s Datenpunkte verarbeitet",
+	"data.results":         "Ergebnisse: %s",
+
+	// 网络活动
+	"network.title":        "Netzwerkaktivität wird überwacht",
+	"network.progress":     "Netzwerk wird analysiert...",
+	"network.complete":     "Netzwerkanalyse abgeschlossen",
+	"network.optimization": "Optimierung: %s",
+
+	// 告警
+	"alert.memory":     "Hohe Speicherauslastung im Worker-Prozess erkannt",
+	"alert.autoscale":  "Auto-Scaling aufgrund erhöhter Last ausgelöst",
+	"alert.threshold":  "Leistungsschwelle in API-Endpunkt überschritten",
+	"alert.pattern":    "Ungewöhnliches Muster im Request-Fluss erkannt",
+	"alert.cache":      "Cache-Trefferquote unter dem optimalen Schwellenwert",
+	"alert.mitigation": "Gegenmaßnahme: %s",
+	"alert.chaos":      "Alarm ausgelöst: %s (durch Chaos-Experiment %s)",
+
+	// 团队活动
+	"team.push":    "Teammitglied pusht Code-Änderungen",
+	"team.merge":   "Merge-Request genehmigt",
+	"team.mergePR": "Merge-Request #%d genehmigt: %s",
+	"team.docs":    "Dokumentationsänderung eingereicht",
+	"team.config":  "Konfigurationsänderungen ausgerollt",
+
+	// 系统事件
+	"event.autoscale": "Container-Auto-Scaling für deployment/%s in %s ausgelöst",
+	"event.cache":     "Cache-Invalidierung für %s abgeschlossen",
+	"event.jobs":      "Hintergrundjobs auf %s-worker abgeschlossen",
+	"event.health":    "Health-Check für %s bestanden",
+	"event.metrics":   "Metrik-Erfassungszyklus abgeschlossen",
+	"event.logrotate": "Log-Rotation für Namespace %s ausgeführt",
+	"event.config":    "Konfiguration neu geladen (configmap/%s-config)",
+	"event.cleanup":   "Ressourcenbereinigung auf %s ausgeführt",
+
+	// 系统建议
+	"sysrec.cache":     "Eine größere Cache-Kapazität würde die Leistung verbessern",
+	"sysrec.jobs":      "Planung der Hintergrundjobs für bessere Ressourcennutzung optimieren",
+	"sysrec.logging":   "Log-Level überprüfen, um den I/O-Overhead zu senken",
+	"sysrec.ratelimit": "Rate-Limiting für Requests einführen",
+	"sysrec.dbpool":    "Einstellungen des Datenbank-Connection-Pools optimieren",
+	"sysrec.autoscale": "Auto-Scaling-Schwellenwerte für bessere Ressourceneffizienz überprüfen",
+
+	// 术语
+	"jargon.insight": "Erkenntnis: %s",
+
+	// 构建
+	"build.title":          "Build mit %s",
+	"build.summary":        "Build nach %s s abgeschlossen: %s, %s, %s",
+	"build.compiled#one":   "%s Einheit kompiliert",
+	"build.compiled#other": "%s Einheiten kompiliert",
+	"build.cached#one":     "%s Cache-Treffer",
+	"build.cached#other":   "%s Cache-Treffer",
+	"build.warnings#one":   "%s Warnung",
+	"build.warnings#other": "%s Warnungen",
+
+	// 测试
+	"tests.title":          "Testsuite wird ausgeführt (%s)",
+	"tests.summary":        "Bestanden %s · Fehlgeschlagen %s · Übersprungen %s · Instabil %s · Abdeckung %s",
+	"tests.failedHint":     "Fehlgeschlagene Tests müssen vor dem Merge behoben werden",
+	"tests.retrying#one":   "%s fehlgeschlagener Test wird wiederholt",
+	"tests.retrying#other": "%s fehlgeschlagene Tests werden wiederholt",
+
+	// git
+	"git.title":         "%s wird mit %s synchronisiert",
+	"git.resolving":     "Konflikt in %s wird aufgelöst",
+	"git.summary":       "%s nach %s gepusht (PR #%d)",
+	"git.commits#one":   "%s Commit",
+	"git.commits#other": "%s Commits",
+
+	// kubernetes
+	"k8s.title":   "Rollout von deployment/%s in %s",
+	"k8s.summary": "deployment/%s ist gesund: %d/%d Replikas bereit nach %s s",
+
+	// terraform
+	"tf.title":   "Infrastrukturänderungen auf %s werden geplant (%s)",
+	"tf.summary": "%s v%s: %d hinzugefügt, %d geändert, %d gelöscht",
+
+	// 数据库
+	"db.title":            "Abfrageleistung von %s wird geprüft",
+	"db.slowQuery":        "Langsame Abfrage erkannt (%s ms)",
+	"db.optimizing":       "Datenbankindizes werden optimiert",
+	"db.improved":         "Ausführungspfade optimiert: %s ms → %s ms (%s× schneller)",
+	"db.migrations#one":   "%s Migration angewendet",
+	"db.migrations#other": "%s Migrationen angewendet",
+
+	// 训练
+	"train.title":     "%s wird mit %s trainiert",
+	"train.earlyStop": "Early Stopping nach %d Epochen, Gewichte aus Epoche %d wiederhergestellt",
+	"train.summary":   "Beste Validierungs-Loss %s, Genauigkeit %s (Epoche %d)",
+
+	// 区块链
+	"chain.syncTitle":   "%s wird mit dem lokalen Devnet synchronisiert",
+	"chain.mempool":     "Mempool: %s ausstehend, %s in Warteschlange, Basisgebühr %s gwei",
+	"chain.synced":      "Kopf %s, finalisiert %s, %d Peers",
+	"chain.deployTitle": "%d Verträge werden im lokalen Devnet bereitgestellt (Chain-ID %d)",
+	"chain.deployed":    "%d Verträge bereitgestellt, insgesamt %s Gas",
+
+	// 安全扫描
+	"sec.title":          "Sicherheitsscans für %s laufen",
+	"sec.summaryTitle":   "Übersicht nach Schweregrad",
+	"sec.summary":        "Sicherheitsscan abgeschlossen: %s, davon %d kritisch oder hoch",
+	"sec.findings#one":   "%s Befund",
+	"sec.findings#other": "%s Befunde",
+
+	// 游戏引擎
+	"game.title":   "%s wird für %s gebaut",
+	"game.cooked":  "%d Assets gekocht: %s MB Quelle, %s MB auf der Platte",
+	"game.shaders": "%s Shader-Permutationen, %s aus dem Derived Data Cache übernommen",
+	"game.hitch":   "Hitch in Frame %d (%s ms) bei %s: %s",
+	"game.fixed":   "Korrektur angewendet: %s",
+	"game.summary": "Durchschnittlicher Frame %s ms (%s FPS), schlechtester %s ms",
+
+	// 日志
+	"logs.title":          "Logs von %s werden verfolgt (%s)",
+	"logs.summary":        "%s aus %s: %d Warnungen, %d Fehler",
+	"logs.lines#one":      "%s Zeile",
+	"logs.lines#other":    "%s Zeilen",
+	"logs.requests#one":   "%s Anfrage",
+	"logs.requests#other": "%s Anfragen",
+
+	// 分布式追踪
+	"trace.title":   "Trace %s: %s %s",
+	"trace.summary": "%d Spans über %d Dienste, %s ms Ende-zu-Ende, HTTP %d",
+
+	// 性能剖析
+	"prof.title":   "Profiling von %s (%s)",
+	"prof.cpu":     "CPU-Zeit",
+	"prof.heap":    "belegter Heap",
+	"prof.flame":   "Flame Graph (%d Spalten)",
+	"prof.hotspot": "Hot Path: %s macht %s der %s aus",
+
+	// CI/CD 流水线
+	"ci.title":         "%s-Pipeline auf %s (%s)",
+	"ci.retry":         "Wiederhole %s (Versuch %d von %d)",
+	"ci.matrix":        "Pipeline-Status",
+	"ci.passed":        "Pipeline #%d erfolgreich in %s: %s, %s",
+	"ci.failed":        "Pipeline #%d nach %s fehlgeschlagen: %s, %s",
+	"ci.jobs#one":      "%s Job",
+	"ci.jobs#other":    "%s Jobs",
+	"ci.retries#one":   "%s Wiederholung",
+	"ci.retries#other": "%s Wiederholungen",
+
+	// 代码评审
+	"review.title":             "Reviewe PR #%d „%s“ von %s",
+	"review.c.constant":        "nit: die magischen Zahlen bitte als benannte Konstanten herausziehen, damit die Grenzen an einer Stelle dokumentiert sind",
+	"review.c.wrap":            "Bitte den Fehler mit Kontext versehen, sonst ist ein fehlgeschlagenes Zählen von %s nicht von einem fehlgeschlagenen Auflisten zu unterscheiden",
+	"review.c.count":           "count(*) auf %s ist bei jeder Seitenanfrage ein Full Scan; können wir das cachen oder eine Schätzung liefern?",
+	"review.c.timeout":         "Können wir das Timeout für %s aus der Konfiguration lesen, statt es fest zu verdrahten?",
+	"review.c.retry":           "Was passiert, wenn %s kurz nicht erreichbar ist? Ein Retry mit Backoff für idempotente Lesezugriffe würde dem On-Call Alarme ersparen",
+	"review.approved":          "%s hat die Änderungen genehmigt",
+	"review.changes":           "%s hat Änderungen angefordert",
+	"review.summary":           "Review abgeschlossen: %s, %s",
+	"review.comments#one":      "%s Kommentar",
+	"review.comments#other":    "%s Kommentare",
+	"review.suggestions#one":   "%s Vorschlag",
+	"review.suggestions#other": "%s Vorschläge",
+
+	// 抓包
+	"pcap.title":         "Zeichne HTTPS-Verkehr von %s zu %s:%d auf",
+	"pcap.decrypt":       "Entschlüssele den HTTP/2-Stream mit den Sitzungsschlüsseln aus %s",
+	"pcap.summary":       "%s: TLS-1.3-Handshake in %s ms, %s %s mit %d beantwortet in %s ms",
+	"pcap.packets#one":   "%s Paket",
+	"pcap.packets#other": "%s Pakete",
+
+	// 运行时诊断
+	"rt.title":        "Sammle Go-Laufzeitdiagnosen von %s",
+	"rt.heap":         "Lebender Heap nach GC wuchs von %s MB auf %s MB über %s",
+	"rt.cycles#one":   "%s Zyklus",
+	"rt.cycles#other": "%s Zyklen",
+	"rt.leak":         "Leck gefunden: %s Goroutinen warten in %s auf einen Kontext, der nie abgebrochen wird",
+	"rt.linked":       "Das entspricht dem Problem „%s“ aus der statischen Analyse:",
+
+	// 压测
+	"lt.title":          "Lasttest von %s mit %s für %s",
+	"lt.live":           "%s: %s Anfr./s, p95 %s, Fehler %s",
+	"lt.buckets":        "Latenzverteilung",
+	"lt.thresholds":     "Schwellenwerte",
+	"lt.summary":        "%s bei %s Anfr./s, p50 %s, p99 %s",
+	"lt.requests#one":   "%s Anfrage",
+	"lt.requests#other": "%s Anfragen",
+	"lt.crossed":        "%d von %d Schwellenwerten eingehalten",
+
+	// 依赖安装
+	"dep.title":            "Installiere Abhängigkeiten für %s mit %s",
+	"dep.summary":          "%s aufgelöst, %s Anforderungen dedupliziert, %s MB in %s s heruntergeladen",
+	"dep.packages#one":     "%s Paket",
+	"dep.packages#other":   "%s Pakete",
+	"dep.mvs":              "Minimal Version Selection wählt %s %s aus %s angeforderten",
+	"dep.versions#one":     "%s Version",
+	"dep.versions#other":   "%s Versionen",
+	"dep.conflict":         "%s hat inkompatible Versionsanforderungen, daher werden diese Kopien parallel gehalten: %s",
+	"dep.shared":           "%s wird von %s gemeinsam genutzt und nur einmal installiert",
+	"dep.dependents#one":   "%s abhängigen Paket",
+	"dep.dependents#other": "%s abhängigen Paketen",
+
+	// 混沌实验
+	"chaos.title":             "Führe Chaos-Experiment %s in %s aus",
+	"chaos.hypothesis":        "Hypothese: Wenn %s, hält %s p99 unter %s ms und Fehler unter %s",
+	"chaos.fault.kill":        "ein Pod von %s beendet wird",
+	"chaos.fault.delay":       "%s ms Latenz in %s injiziert werden",
+	"chaos.fault.partition":   "%s von %s abgetrennt wird",
+	"chaos.steady":            "Stabiler Zustand: %s Anfr./s, p50 %s ms, p99 %s ms, Fehler %s",
+	"chaos.live":              "%s: %s Anfr./s, p99 %s ms, Fehler %s",
+	"chaos.abort":             "Fehlerrate hat die Abbruchbedingung von %s überschritten, Experiment wird pausiert",
+	"chaos.graph.p99":         "p99-Latenz von %s (ein Balken pro %d s)",
+	"chaos.graph.errors":      "Fehlerrate von %s (ein Balken pro %d s)",
+	"chaos.passed":            "Hypothese bestätigt: schlechteste p99 %s ms, schlechteste Fehlerrate %s",
+	"chaos.failed":            "Hypothese widerlegt: schlechteste p99 %s ms, schlechteste Fehlerrate %s",
+	"chaos.finding.kill":      "%s wiederholt keine Anfragen, die auf dem beendeten Pod von %s liefen",
+	"chaos.finding.delay":     "Das Client-Timeout von %[2]s ms in %[1]s lässt keinen Spielraum für ein langsames %[3]s",
+	"chaos.finding.partition": "%s hat keinen Fallback, wenn %s nicht erreichbar ist, und gibt die Fehler an seine Aufrufer weiter",
+}
@@ -0,0 +1,280 @@
+package main
+
+// 英语消息目录，也是其他语言缺失条目时的回退
+var catalogEN = map[string]string{
+	// 启动与退出
+	"boot.init":          "Initializing system...",
+	"boot.config":        "Loading configuration...",
+	"boot.project":       "Project: %s",
+	"boot.module":        "Module: %s",
+	"boot.namespace":     "Namespace: %s",
+	"boot.workspace":     "Workspace: %s",
+	"boot.framework":     "Framework: %s",
+	"session.terminated": "Session terminated.",
+
+	// 代码分析
+	"analysis.title":          "Running Code Analysis%s",
+	"analysis.progress":       "Analyzing files...",
+	"analysis.complete":       "Analysis Complete: %s, %s",
+	"analysis.files#one":      "%s file",
+	"analysis.files#other":    "%s files",
+	"analysis.lines#one":      "%s line of code",
+	"analysis.lines#other":    "%s lines of code",
+	"analysis.issues":         "Issues found: %s (%s, %s, %s)",
+	"analysis.errors#one":     "%s error",
+	"analysis.errors#other":   "%s errors",
+	"analysis.warnings#one":   "%s warning",
+	"analysis.warnings#other": "%s warnings",
+	"analysis.notes#one":      "%s note",
+	"analysis.notes#other":    "%s notes",
+	"analysis.fixable#one":    "%s issue has a suggested fix",
+	"analysis.fixable#other":  "%s issues have suggested fixes",
+	"analysis.quality":        "Code quality score: %s",
+	"analysis.debt":           "Technical debt: %s",
+	"analysis.fix":            "fix: %s",
+
+	// 代码分析的问题类别
+	"analysis.issue.memoryLeak":   "Potential memory leak",
+	"analysis.issue.uncaught":     "Uncaught exception",
+	"analysis.issue.resource":     "Resource not released",
+	"analysis.issue.inefficient":  "Inefficient algorithm",
+	"analysis.issue.security":     "Security vulnerability",
+	"analysis.issue.maintainable": "Maintainability",
+
+	// 性能分析
+	"perf.title":          "Performance Analysis",
+	"perf.progress":       "Collecting metrics...",
+	"perf.results":        "Performance Results:",
+	"perf.average":        "Average: %s ms",
+	"perf.median":         "Median: %s ms",
+	"perf.p95":            "P95: %s ms",
+	"perf.p99":            "P99: %s ms",
+	"perf.recommendation": "Recommendation: %s",
+
+	// 系统监控
+	"monitor.title":      "System Resource Monitoring",
+	"monitor.progress":   "Monitoring...",
+	"monitor.line":       "CPU: %s  |  RAM: %s  |  Network: %s MB/s  |  Disk I/O: %s MB/s  |  Processes: %s",
+	"monitor.summary":    "Resource Utilization Summary:",
+	"monitor.peakCPU":    "Peak CPU: %s",
+	"monitor.peakMemory": "Peak Memory: %s",
+	"monitor.network":    "Network Throughput: %s MB/s",
+	"monitor.disk":       "Disk Throughput: %s MB/s",
+
+	// 数据处理
+	"data.title":           "Processing Data Streams",
+	"data.progress":        "Processing data...",
+	"data.processed#one":   "Processed %s data point",
+	"data.processed#other": "Processed %s data points",
+	"data.results":         "Results: %s",
+
+	// 网络活动
+	"network.title":        "Monitoring Network Activity",
+	"network.progress":     "Analyzing network...",
+	"network.complete":     "Network Analysis Complete",
+	"network.optimization": "Optimization: %s",
+
+	// 告警
+	"alert.memory":     "High memory usage detected in worker process",
+	"alert.autoscale":  "Auto-scaling triggered due to increased load",
+	"alert.threshold":  "Performance threshold exceeded in API endpoint",
+	"alert.pattern":    "Unusual pattern detected in request flow",
+	"alert.cache":      "Cache hit ratio below optimal threshold",
+	"alert.mitigation": "Mitigation: %s",
+	"alert.chaos":      "Alert firing: %s (triggered by chaos experiment %s)",
+
+	// 团队活动
+	"team.push":    "Team member pushing code updates",
+	"team.merge":   "Merge request approved",
+	"team.mergePR": "Merge request #%d approved: %s",
+	"team.docs":    "Documentation update submitted",
+	"team.config":  "Configuration changes deployed",
+
+	// 系统事件
+	"event.autoscale": "Container auto-scaling event triggered for deployment/%s in %s",
+	"event.cache":     "Cache invalidation completed for %s",
+	"event.jobs":      "Background job processing completed on %s-worker",
+	"event.health":    "System health check passed for %s",
+	"event.metrics":   "Metrics collection cycle completed",
+	"event.logrotate": "Log rotation executed for namespace %s",
+	"event.config":    "Configuration refresh completed (configmap/%s-config)",
+	"event.cleanup":   "Resource cleanup task executed on %s",
+
+	// 系统建议
+	"sysrec.cache":     "Consider increasing cache size for improved performance",
+	"sysrec.jobs":      "Optimize background job scheduling for better resource utilization",
+	"sysrec.logging":   "Review logging levels to reduce I/O overhead",
+	"sysrec.ratelimit": "Consider implementing request rate limiting",
+	"sysrec.dbpool":    "Optimize database connection pool settings",
+	"sysrec.autoscale": "Review auto-scaling thresholds for better resource efficiency",
+
+	// 术语
+	"jargon.insight": "Insight: %s",
+
+	// 构建
+	"build.title":          "Building with %s",
+	"build.summary":        "Build finished in %s s: %s, %s, %s",
+	"build.compiled#one":   "%s unit compiled",
+	"build.compiled#other": "%s units compiled",
+	"build.cached#one":     "%s cache hit",
+	"build.cached#other":   "%s cache hits",
+	"build.warnings#one":   "%s warning",
+	"build.warnings#other": "%s warnings",
+
+	// 测试
+	"tests.title":          "Running test suite (%s)",
+	"tests.summary":        "Passed %s · Failed %s · Skipped %s · Flaky %s · Coverage %s",
+	"tests.failedHint":     "Failing tests need attention before merge",
+	"tests.retrying#one":   "Retrying %s failed test",
+	"tests.retrying#other": "Retrying %s failed tests",
+
+	// git
+	"git.title":         "Syncing %s with %s",
+	"git.resolving":     "Resolving conflict in %s",
+	"git.summary":       "Pushed %s to %s (PR #%d)",
+	"git.commits#one":   "%s commit",
+	"git.commits#other": "%s commits",
+
+	// kubernetes
+	"k8s.title":   "Rolling out deployment/%s in %s",
+	"k8s.summary": "deployment/%s healthy: %d/%d replicas ready after %s s",
+
+	// terraform
+	"tf.title":   "Planning infrastructure changes on %s (%s)",
+	"tf.summary": "%s v%s: %d added, %d changed, %d destroyed",
+
+	// 数据库
+	"db.title":            "Reviewing query performance on %s",
+	"db.slowQuery":        "Slow query detected (%s ms)",
+	"db.optimizing":       "Optimizing database indexes",
+	"db.improved":         "Optimized query execution paths: %s ms → %s ms (%s× faster)",
+	"db.migrations#one":   "%s migration applied",
+	"db.migrations#other": "%s migrations applied",
+
+	// 训练
+	"train.title":     "Training %s with %s",
+	"train.earlyStop": "Early stopping after %d epochs, restored weights from epoch %d",
+	"train.summary":   "Best validation loss %s, accuracy %s (epoch %d)",
+
+	// 区块链
+	"chain.syncTitle":   "Syncing %s with the local devnet",
+	"chain.mempool":     "Mempool: %s pending, %s queued, base fee %s gwei",
+	"chain.synced":      "Head %s, finalized %s, %d peers",
+	"chain.deployTitle": "Deploying %d contracts to the local devnet (chain id %d)",
+	"chain.deployed":    "%d contracts deployed, %s gas in total",
+
+	// 安全扫描
+	"sec.title":          "Running security scans on %s",
+	"sec.summaryTitle":   "Severity summary",
+	"sec.summary":        "Security scan complete: %s, %d critical or high",
+	"sec.findings#one":   "%s finding",
+	"sec.findings#other": "%s findings",
+
+	// 游戏引擎
+	"game.title":   "Building %s for %s",
+	"game.cooked":  "Cooked %d assets: %s MB source, %s MB on disk",
+	"game.shaders": "%s shader permutations, %s reused from the derived data cache",
+	"game.hitch":   "Hitch in frame %d (%s ms) on %s: %s",
+	"game.fixed":   "Fix applied: %s",
+	"game.summary": "Average frame %s ms (%s FPS), worst %s ms",
+
+	// 日志
+	"logs.title":          "Tailing logs from %s (%s)",
+	"logs.summary":        "%s from %s: %d warnings, %d errors",
+	"logs.lines#one":      "%s line",
+	"logs.lines#other":    "%s lines",
+	"logs.requests#one":   "%s request",
+	"logs.requests#other": "%s requests",
+
+	// 分布式追踪
+	"trace.title":   "Trace %s: %s %s",
+	"trace.summary": "%d spans across %d services, %s ms end to end, HTTP %d",
+
+	// 性能剖析
+	"prof.title":   "Profiling %s (%s)",
+	"prof.cpu":     "CPU time",
+	"prof.heap":    "in-use heap",
+	"prof.flame":   "Flame graph (%d columns)",
+	"prof.hotspot": "Hot path: %s accounts for %s of %s",
+
+	// CI/CD 流水线
+	"ci.title":         "%s pipeline on %s (%s)",
+	"ci.retry":         "Retrying %s (attempt %d of %d)",
+	"ci.matrix":        "Pipeline status",
+	"ci.passed":        "Pipeline #%d passed in %s: %s, %s",
+	"ci.failed":        "Pipeline #%d failed after %s: %s, %s",
+	"ci.jobs#one":      "%s job",
+	"ci.jobs#other":    "%s jobs",
+	"ci.retries#one":   "%s retry",
+	"ci.retries#other": "%s retries",
+
+	// 代码评审
+	"review.title":             "Reviewing PR #%d \"%s\" by %s",
+	"review.c.constant":        "nit: pull the magic numbers out into named constants so the limits are documented in one place",
+	"review.c.wrap":            "Wrap this error with context, otherwise a failed count on %s is indistinguishable from a failed list",
+	"review.c.count":           "count(*) on %s is a full scan on every page request; can we cache it or return an estimate?",
+	"review.c.timeout":         "Can we read the %s timeout from config instead of hard-coding it?",
+	"review.c.retry":           "What happens when %s is briefly unavailable? A retry with backoff for idempotent reads would avoid paging on-call",
+	"review.approved":          "%s approved these changes",
+	"review.changes":           "%s requested changes",
+	"review.summary":           "Review finished: %s, %s",
+	"review.comments#one":      "%s comment",
+	"review.comments#other":    "%s comments",
+	"review.suggestions#one":   "%s suggestion",
+	"review.suggestions#other": "%s suggestions",
+
+	// 抓包
+	"pcap.title":         "Capturing HTTPS traffic from %s to %s:%d",
+	"pcap.decrypt":       "Decrypting the HTTP/2 stream with session keys from %s",
+	"pcap.summary":       "%s: TLS 1.3 handshake in %s ms, %s %s answered %d in %s ms",
+	"pcap.packets#one":   "%s packet",
+	"pcap.packets#other": "%s packets",
+
+	// 运行时诊断
+	"rt.title":        "Collecting Go runtime diagnostics from %s",
+	"rt.heap":         "Live heap after GC grew from %s MB to %s MB across %s",
+	"rt.cycles#one":   "%s cycle",
+	"rt.cycles#other": "%s cycles",
+	"rt.leak":         "Leak found: %s goroutines are parked in %s waiting on a context that is never cancelled",
+	"rt.linked":       "This matches the \"%s\" issue reported by static analysis:",
+
+	// 压测
+	"lt.title":          "Load testing %s with %s for %s",
+	"lt.live":           "%s: %s req/s, p95 %s, errors %s",
+	"lt.buckets":        "Latency distribution",
+	"lt.thresholds":     "Thresholds",
+	"lt.summary":        "%s at %s req/s, p50 %s, p99 %s",
+	"lt.requests#one":   "%s request",
+	"lt.requests#other": "%s requests",
+	"lt.crossed":        "%d of %d thresholds passed",
+
+	// 依赖安装
+	"dep.title":            "Installing dependencies for %s with %s",
+	"dep.summary":          "%s resolved, %s requirements deduplicated, %s MB downloaded in %s s",
+	"dep.packages#one":     "%s package",
+	"dep.packages#other":   "%s packages",
+	"dep.mvs":              "Minimal version selection picks %s %s out of %s requested",
+	"dep.versions#one":     "%s version",
+	"dep.versions#other":   "%s versions",
+	"dep.conflict":         "%s has incompatible version requirements, so these copies are kept side by side: %s",
+	"dep.shared":           "%s is shared by %s and installed only once",
+	"dep.dependents#one":   "%s dependent",
+	"dep.dependents#other": "%s dependents",
+
+	// 混沌实验
+	"chaos.title":             "Running chaos experiment %s in %s",
+	"chaos.hypothesis":        "Hypothesis: when %s, %s keeps p99 below %s ms and errors below %s",
+	"chaos.fault.kill":        "a pod of %s is killed",
+	"chaos.fault.delay":       "%s ms of latency is injected into %s",
+	"chaos.fault.partition":   "%s is partitioned from %s",
+	"chaos.steady":            "Steady state: %s req/s, p50 %s ms, p99 %s ms, errors %s",
+	"chaos.live":              "%s: %s req/s, p99 %s ms, errors %s",
+	"chaos.abort":             "Error rate crossed the abort condition of %s, pausing the experiment",
+	"chaos.graph.p99":         "p99 latency of %s (one bar per %d s)",
+	"chaos.graph.errors":      "Error rate of %s (one bar per %d s)",
+	"chaos.passed":            "Hypothesis held: worst p99 %s ms, worst error rate %s",
+	"chaos.failed":            "Hypothesis failed: worst p99 %s ms, worst error rate %s",
+	"chaos.finding.kill":      "%s does not retry requests that were in flight on the killed %s pod",
+	"chaos.finding.delay":     "The %[2]s ms client timeout in %[1]s leaves no headroom for a slow %[3]s",
+	"chaos.finding.partition": "%s has no fallback when %s is unreachable and surfaces the failures to its callers",
+}
@@ -0,0 +1,254 @@
+package main
+
+// 日语消息目录
+var catalogJA = map[string]string{
+	// 启动与退出
+	"boot.init":          "システムを初期化しています...",
+	"boot.config":        "設定を読み込んでいます...",
+	"boot.project":       "プロジェクト: %s",
+	"boot.module":        "モジュール: %s",
+	"boot.namespace":     "名前空間: %s",
+	"boot.workspace":     "ワークスペース: %s",
+	"boot.framework":     "フレームワーク: %s",
+	"session.terminated": "セッションを終了しました。",
+
+	// 代码分析
+	"analysis.title":          "コード解析を実行中%s",
+	"analysis.progress":       "ファイルを解析中...",
+	"analysis.complete":       "解析完了: %s、%s",
+	"analysis.files#other":    "%s ファイル",
+	"analysis.lines#other":    "%s 行のコード",
+	"analysis.issues":         "検出された問題: %s (%s、%s、%s)",
+	"analysis.errors#other":   "エラー %s 件",
+	"analysis.warnings#other": "警告 %s 件",
+	"analysis.notes#other":    "情報 %s 件",
+	"analysis.fixable#other":  "%s 件の問題に修正案があります",
+	"analysis.quality":        "コード品質スコア: %s",
+	"analysis.debt":           "技術的負債: %s",
+	"analysis.fix":            "修正: %s",
+
+	// 代码分析的问题类别
+	"analysis.issue.memoryLeak":   "メモリリークの可能性",
+	"analysis.issue.uncaught":     "捕捉されない例外",
+	"analysis.issue.resource":     "リソースの解放漏れ",
+	"analysis.issue.inefficient":  "非効率なアルゴリズム",
+	"analysis.issue.security":     "セキュリティ脆弱性",
+	"analysis.issue.maintainable": "保守性",
+
+	// 性能分析
+	"perf.title":          "パフォーマンス分析",
+	"perf.progress":       "メトリクスを収集中...",
+	"perf.results":        "パフォーマンス結果:",
+	"perf.average":        "平均: %s ms",
+	"perf.median":         "中央値: %s ms",
+	"perf.p95":            "P95: %s ms",
+	"perf.p99":            "P99: %s ms",
+	"perf.recommendation": "推奨事項: %s",
+
+	// 系统监控
+	"monitor.title":      "システムリソース監視",
+	"monitor.progress":   "監視中...",
+	"monitor.line":       "CPU: %s  |  メモリ: %s  |  ネットワーク: %s MB/s  |  ディスク I/O: %s MB/s  |  プロセス数: %s",
+	"monitor.summary":    "リソース使用状況の概要:",
+	"monitor.peakCPU":    "CPU ピーク: %s",
+	"monitor.peakMemory": "メモリピーク: %s",
+	"monitor.network":    "ネットワークスループット: %s MB/s",
+	"monitor.disk":       "ディスクスループット: %s MB/s",
+
+	// 数据处理
+	"data.title":           "データストリームを処理中",
+	"data.progress":        "データを処理中...",
+	"data.processed#other": "%s 件のデータポイントを処理しました",
+	"data.results":         "結果: %s",
+
+	// 网络活动
+	"network.title":        "ネットワークアクティビティを監視中",
+	"network.progress":     "ネットワークを解析中...",
+	"network.complete":     "ネットワーク解析完了",
+	"network.optimization": "最適化: %s",
+
+	// 告警
+	"alert.memory":     "ワーカープロセスで高いメモリ使用量を検出しました",
+	"alert.autoscale":  "負荷増加によりオートスケーリングが発動しました",
+	"alert.threshold":  "API エンドポイントでパフォーマンスしきい値を超過しました",
+	"alert.pattern":    "リクエストフローで異常なパターンを検出しました",
+	"alert.cache":      "キャッシュヒット率が最適しきい値を下回っています",
+	"alert.mitigation": "緩和策: %s",
+	"alert.chaos":      "アラート発火：%s（カオス実験 %s による）",
+
+	// 团队活动
+	"team.push":    "チームメンバーがコードをプッシュしています",
+	"team.merge":   "マージリクエストが承認されました",
+	"team.mergePR": "マージリクエスト #%d が承認されました: %s",
+	"team.docs":    "ドキュメントの更新が提出されました",
+	"team.config":  "設定変更がデプロイされました",
+
+	// 系统事件
+	"event.autoscale": "%[2]s の deployment/%[1]s でコンテナのオートスケーリングが発生しました",
+	"event.cache":     "%s のキャッシュ無効化が完了しました",
+	"event.jobs":      "%s-worker のバックグラウンドジョブ処理が完了しました",
+	"event.health":    "%s のヘルスチェックに合格しました",
+	"event.metrics":   "メトリクス収集サイクルが完了しました",
+	"event.logrotate": "名前空間 %s のログローテーションを実行しました",
+	"event.config":    "設定の再読み込みが完了しました (configmap/%s-config)",
+	"event.cleanup":   "%s でリソースのクリーンアップを実行しました",
+
+	// 系统建议
+	"sysrec.cache":     "パフォーマンス向上のためキャッシュサイズの拡大を検討してください",
+	"sysrec.jobs":      "リソース効率向上のためバックグラウンドジョブのスケジュールを最適化してください",
+	"sysrec.logging":   "I/O 負荷を減らすためログレベルを見直してください",
+	"sysrec.ratelimit": "リクエストのレート制限の導入を検討してください",
+	"sysrec.dbpool":    "データベース接続プールの設定を最適化してください",
+	"sysrec.autoscale": "リソース効率向上のためオートスケーリングのしきい値を見直してください",
+
+	// 术语
+	"jargon.insight": "インサイト: %s",
+
+	// 构建
+	"build.title":          "%s でビルド中",
+	"build.summary":        "ビルド完了 (%s 秒): %s、%s、%s",
+	"build.compiled#other": "%s 件をコンパイル",
+	"build.cached#other":   "キャッシュヒット %s 件",
+	"build.warnings#other": "警告 %s 件",
+
+	// 测试
+	"tests.title":          "テストスイートを実行中 (%s)",
+	"tests.summary":        "成功 %s · 失敗 %s · スキップ %s · 不安定 %s · カバレッジ %s",
+	"tests.failedHint":     "マージ前に失敗したテストの対応が必要です",
+	"tests.retrying#other": "失敗したテスト %s 件を再実行中",
+
+	// git
+	"git.title":         "%s を %s と同期中",
+	"git.resolving":     "%s のコンフリクトを解消中",
+	"git.summary":       "%s を %s にプッシュしました (PR #%d)",
+	"git.commits#other": "%s 件のコミット",
+
+	// kubernetes
+	"k8s.title":   "deployment/%s を %s にロールアウト中",
+	"k8s.summary": "deployment/%s 正常: %d/%d レプリカが準備完了 (%s 秒)",
+
+	// terraform
+	"tf.title":   "%s のインフラ変更を計画中 (%s)",
+	"tf.summary": "%s v%s: 追加 %d、変更 %d、削除 %d",
+
+	// 数据库
+	"db.title":            "%s のクエリ性能を確認中",
+	"db.slowQuery":        "スロークエリを検出 (%s ms)",
+	"db.optimizing":       "データベースインデックスを最適化中",
+	"db.improved":         "クエリ実行パスを最適化: %s ms → %s ms (%s 倍高速)",
+	"db.migrations#other": "%s 件のマイグレーションを適用",
+
+	// 训练
+	"train.title":     "%[2]s で %[1]s を学習中",
+	"train.earlyStop": "%d エポックで早期終了、エポック %d の重みを復元しました",
+	"train.summary":   "最良の検証損失 %s、精度 %s (エポック %d)",
+
+	// 区块链
+	"chain.syncTitle":   "%s をローカル devnet と同期中",
+	"chain.mempool":     "メモリプール: 保留 %s、キュー %s、ベース手数料 %s gwei",
+	"chain.synced":      "ヘッド %s、ファイナライズ済み %s、ピア %d",
+	"chain.deployTitle": "%d 個のコントラクトをローカル devnet にデプロイ中 (chain id %d)",
+	"chain.deployed":    "%d 個のコントラクトをデプロイ、合計 %s gas",
+
+	// 安全扫描
+	"sec.title":          "%s のセキュリティスキャンを実行中",
+	"sec.summaryTitle":   "重大度の集計",
+	"sec.summary":        "セキュリティスキャン完了: %s、うち重大または高 %d 件",
+	"sec.findings#other": "検出 %s 件",
+
+	// 游戏引擎
+	"game.title":   "%[2]s 向けに %[1]s をビルド中",
+	"game.cooked":  "%d 個のアセットをクック: ソース %s MB、出力 %s MB",
+	"game.shaders": "シェーダーパーミュテーション %s 個、うち %s 個を派生データキャッシュから再利用",
+	"game.hitch":   "フレーム %d でヒッチ (%s ms)、%s の %s が原因",
+	"game.fixed":   "修正を適用: %s",
+	"game.summary": "平均フレーム %s ms (%s FPS)、最悪 %s ms",
+
+	// 日志
+	"logs.title":          "%s のログを追跡中 (%s)",
+	"logs.summary":        "%[2]s から %[1]s: 警告 %[3]d 件、エラー %[4]d 件",
+	"logs.lines#other":    "%s 行",
+	"logs.requests#other": "%s 件のリクエスト",
+
+	// 分布式追踪
+	"trace.title":   "トレース %s: %s %s",
+	"trace.summary": "%d 個のスパン、%d サービス、エンドツーエンド %s ms、HTTP %d",
+
+	// 性能剖析
+	"prof.title":   "%s をプロファイリング中 (%s)",
+	"prof.cpu":     "CPU 時間",
+	"prof.heap":    "使用中のヒープ",
+	"prof.flame":   "フレームグラフ (%d 列)",
+	"prof.hotspot": "ホットパス: %s が%[3]sの %[2]s を占めています",
+
+	// CI/CD 流水线
+	"ci.title":         "%s パイプライン: %s (%s)",
+	"ci.retry":         "%s を再試行中 (%d/%d 回目)",
+	"ci.matrix":        "パイプラインの状態",
+	"ci.passed":        "パイプライン #%d が成功しました (%s): %s、%s",
+	"ci.failed":        "パイプライン #%d が失敗しました (%s): %s、%s",
+	"ci.jobs#other":    "%s 個のジョブ",
+	"ci.retries#other": "%s 回の再試行",
+
+	// 代码评审
+	"review.title":             "%[3]s の PR #%[1]d「%[2]s」をレビュー中",
+	"review.c.constant":        "nit: マジックナンバーは名前付き定数に切り出して、上限を一か所で説明できるようにしましょう",
+	"review.c.wrap":            "このエラーにはコンテキストを付けてください。%s の件数取得の失敗と一覧取得の失敗が区別できません",
+	"review.c.count":           "%s への count(*) はページを取得するたびにフルスキャンになります。キャッシュするか推定値を返せませんか？",
+	"review.c.timeout":         "%s のタイムアウトはハードコードせずに設定から読めませんか？",
+	"review.c.retry":           "%s が一時的に落ちたらどうなりますか？冪等な読み取りにはバックオフ付きのリトライを入れればオンコールを起こさずに済みます",
+	"review.approved":          "%s が変更を承認しました",
+	"review.changes":           "%s が修正を依頼しました",
+	"review.summary":           "レビュー完了: %s、%s",
+	"review.comments#other":    "%s 件のコメント",
+	"review.suggestions#other": "%s 件の提案",
+
+	// 抓包
+	"pcap.title":         "%s から %s:%d への HTTPS トラフィックをキャプチャ中",
+	"pcap.decrypt":       "%s のセッションキーで HTTP/2 ストリームを復号中",
+	"pcap.summary":       "%s: TLS 1.3 ハンドシェイク %s ms、%s %s は %[6]s ms で %[5]d を返しました",
+	"pcap.packets#other": "%s 個のパケット",
+
+	// 运行时诊断
+	"rt.title":        "%s から Go ランタイムの診断情報を収集中",
+	"rt.heap":         "GC 後の生存ヒープが %[3]s で %[1]s MB から %[2]s MB に増加",
+	"rt.cycles#other": "%s 回の GC",
+	"rt.leak":         "リークを検出: %s 個の goroutine が %s で、キャンセルされない context を待ち続けています",
+	"rt.linked":       "静的解析で報告された「%s」の問題と一致します:",
+
+	// 压测
+	"lt.title":          "%[2]s で %[1]s を %[3]s 負荷試験中",
+	"lt.live":           "%s: %s req/s、p95 %s、エラー率 %s",
+	"lt.buckets":        "レイテンシ分布",
+	"lt.thresholds":     "しきい値",
+	"lt.summary":        "%s、%s req/s、p50 %s、p99 %s",
+	"lt.requests#other": "%s 件のリクエスト",
+	"lt.crossed":        "%[2]d 件中 %[1]d 件のしきい値を満たしました",
+
+	// 依赖安装
+	"dep.title":            "%[2]s で %[1]s の依存関係をインストールしています",
+	"dep.summary":          "%s を解決、%s 件の依存宣言を重複排除、%[3]s MB を %[4]s 秒でダウンロード",
+	"dep.packages#other":   "%s 個のパッケージ",
+	"dep.mvs":              "最小バージョン選択により、要求された %[3]sの中から %[1]s は %[2]s に決定",
+	"dep.versions#other":   "%s 個のバージョン",
+	"dep.conflict":         "%s はバージョン要件が互いに非互換のため、次の版が並存します：%s",
+	"dep.shared":           "%s は %s で共有され、一度だけインストールされます",
+	"dep.dependents#other": "%s 個のパッケージ",
+
+	// 混沌实验
+	"chaos.title":             "%[2]s でカオス実験 %[1]s を実行しています",
+	"chaos.hypothesis":        "仮説：%sとき、%s の p99 は %s ms 未満、エラー率は %s 未満に保たれる",
+	"chaos.fault.kill":        "%s の Pod が 1 つ停止された",
+	"chaos.fault.delay":       "%[2]s に %[1]s ms の遅延を注入した",
+	"chaos.fault.partition":   "%s と %s の間でネットワーク分断が起きた",
+	"chaos.steady":            "定常状態：%s リクエスト/秒、p50 %s ms、p99 %s ms、エラー率 %s",
+	"chaos.live":              "%s：%s リクエスト/秒、p99 %s ms、エラー率 %s",
+	"chaos.abort":             "エラー率が中止条件 %s を超えたため、実験を一時停止します",
+	"chaos.graph.p99":         "%s の p99 レイテンシ（1 本 = %d 秒）",
+	"chaos.graph.errors":      "%s のエラー率（1 本 = %d 秒）",
+	"chaos.passed":            "仮説は成立：最悪 p99 %s ms、最大エラー率 %s",
+	"chaos.failed":            "仮説は不成立：最悪 p99 %s ms、最大エラー率 %s",
+	"chaos.finding.kill":      "%s は停止された %s の Pod 上で処理中だったリクエストを再試行しない",
+	"chaos.finding.delay":     "%[1]s のクライアントタイムアウト %[2]s ms では、%[3]s が遅くなったときの余裕がない",
+	"chaos.finding.partition": "%s には %s に到達できないときのフォールバックがなく、エラーが呼び出し元に伝わる",
+}
@@ -0,0 +1,254 @@
+package main
+
+// 简体中文消息目录
+var catalogZhCN = map[string]string{
+	// 启动与退出
+	"boot.init":          "正在初始化系统...",
+	"boot.config":        "正在加载配置...",
+	"boot.project":       "项目：%s",
+	"boot.module":        "模块：%s",
+	"boot.namespace":     "命名空间：%s",
+	"boot.workspace":     "工作目录：%s",
+	"boot.framework":     "框架：%s",
+	"session.terminated": "会话已结束。",
+
+	// 代码分析
+	"analysis.title":          "正在运行代码分析%s",
+	"analysis.progress":       "正在分析文件...",
+	"analysis.complete":       "分析完成：%s，%s",
+	"analysis.files#other":    "%s 个文件",
+	"analysis.lines#other":    "%s 行代码",
+	"analysis.issues":         "发现问题：%s（%s，%s，%s）",
+	"analysis.errors#other":   "%s 个错误",
+	"analysis.warnings#other": "%s 个警告",
+	"analysis.notes#other":    "%s 个提示",
+	"analysis.fixable#other":  "%s 个问题附有修复建议",
+	"analysis.quality":        "代码质量评分：%s",
+	"analysis.debt":           "技术债务：%s",
+	"analysis.fix":            "修复：%s",
+
+	// 代码分析的问题类别
+	"analysis.issue.memoryLeak":   "潜在的内存泄漏",
+	"analysis.issue.uncaught":     "未捕获的异常",
+	"analysis.issue.resource":     "资源未释放",
+	"analysis.issue.inefficient":  "低效的算法",
+	"analysis.issue.security":     "安全漏洞",
+	"analysis.issue.maintainable": "可维护性",
+
+	// 性能分析
+	"perf.title":          "性能分析",
+	"perf.progress":       "正在收集指标...",
+	"perf.results":        "性能结果：",
+	"perf.average":        "平均值：%s ms",
+	"perf.median":         "中位数：%s ms",
+	"perf.p95":            "P95：%s ms",
+	"perf.p99":            "P99：%s ms",
+	"perf.recommendation": "建议：%s",
+
+	// 系统监控
+	"monitor.title":      "系统资源监控",
+	"monitor.progress":   "监控中...",
+	"monitor.line":       "CPU：%s  |  内存：%s  |  网络：%s MB/s  |  磁盘 I/O：%s MB/s  |  进程数：%s",
+	"monitor.summary":    "资源使用汇总：",
+	"monitor.peakCPU":    "CPU 峰值：%s",
+	"monitor.peakMemory": "内存峰值：%s",
+	"monitor.network":    "网络吞吐量：%s MB/s",
+	"monitor.disk":       "磁盘吞吐量：%s MB/s",
+
+	// 数据处理
+	"data.title":           "正在处理数据流",
+	"data.progress":        "正在处理数据...",
+	"data.processed#other": "已处理 %s 个数据点",
+	"data.results":         "结果：%s",
+
+	// 网络活动
+	"network.title":        "正在监控网络活动",
+	"network.progress":     "正在分析网络...",
+	"network.complete":     "网络分析完成",
+	"network.optimization": "优化：%s",
+
+	// 告警
+	"alert.memory":     "检测到工作进程内存占用过高",
+	"alert.autoscale":  "负载上升，已触发自动扩容",
+	"alert.threshold":  "API 端点超出性能阈值",
+	"alert.pattern":    "请求流中检测到异常模式",
+	"alert.cache":      "缓存命中率低于最佳阈值",
+	"alert.mitigation": "缓解措施：%s",
+	"alert.chaos":      "告警触发：%s（由混沌实验 %s 引发）",
+
+	// 团队活动
+	"team.push":    "团队成员正在推送代码更新",
+	"team.merge":   "合并请求已批准",
+	"team.mergePR": "合并请求 #%d 已批准：%s",
+	"team.docs":    "已提交文档更新",
+	"team.config":  "配置变更已部署",
+
+	// 系统事件
+	"event.autoscale": "已为 %[2]s 中的 deployment/%[1]s 触发容器自动扩缩容",
+	"event.cache":     "%s 的缓存失效已完成",
+	"event.jobs":      "%s-worker 上的后台任务处理已完成",
+	"event.health":    "%s 系统健康检查通过",
+	"event.metrics":   "指标采集周期已完成",
+	"event.logrotate": "已对命名空间 %s 执行日志轮转",
+	"event.config":    "配置刷新已完成（configmap/%s-config）",
+	"event.cleanup":   "已在 %s 上执行资源清理任务",
+
+	// 系统建议
+	"sysrec.cache":     "建议增大缓存容量以提升性能",
+	"sysrec.jobs":      "优化后台任务调度以提高资源利用率",
+	"sysrec.logging":   "检查日志级别以降低 I/O 开销",
+	"sysrec.ratelimit": "建议实施请求限流",
+	"sysrec.dbpool":    "优化数据库连接池设置",
+	"sysrec.autoscale": "检查自动扩缩容阈值以提高资源效率",
+
+	// 术语
+	"jargon.insight": "洞察：%s",
+
+	// 构建
+	"build.title":          "正在使用 %s 构建",
+	"build.summary":        "构建完成，耗时 %s 秒：%s，%s，%s",
+	"build.compiled#other": "编译 %s 个单元",
+	"build.cached#other":   "缓存命中 %s 次",
+	"build.warnings#other": "%s 个警告",
+
+	// 测试
+	"tests.title":          "正在运行测试套件（%s）",
+	"tests.summary":        "通过 %s · 失败 %s · 跳过 %s · 不稳定 %s · 覆盖率 %s",
+	"tests.failedHint":     "合并前需要修复失败的测试",
+	"tests.retrying#other": "正在重试 %s 个失败的测试",
+
+	// git
+	"git.title":         "正在将 %s 同步到 %s",
+	"git.resolving":     "正在解决 %s 中的冲突",
+	"git.summary":       "已推送 %s 到 %s（PR #%d）",
+	"git.commits#other": "%s 个提交",
+
+	// kubernetes
+	"k8s.title":   "正在滚动发布 deployment/%s（命名空间 %s）",
+	"k8s.summary": "deployment/%s 运行正常：%d/%d 个副本就绪，耗时 %s 秒",
+
+	// terraform
+	"tf.title":   "正在规划 %s 上的基础设施变更（%s）",
+	"tf.summary": "%s v%s：新增 %d，修改 %d，销毁 %d",
+
+	// 数据库
+	"db.title":            "正在检查 %s 的查询性能",
+	"db.slowQuery":        "检测到慢查询（%s 毫秒）",
+	"db.optimizing":       "正在优化数据库索引",
+	"db.improved":         "已优化查询执行路径：%s 毫秒 → %s 毫秒（快 %s 倍）",
+	"db.migrations#other": "已应用 %s 个迁移",
+
+	// 训练
+	"train.title":     "正在使用 %[2]s 训练 %[1]s",
+	"train.earlyStop": "训练 %d 个 epoch 后提前停止，已恢复第 %d 个 epoch 的权重",
+	"train.summary":   "最佳验证损失 %s，准确率 %s（第 %d 个 epoch）",
+
+	// 区块链
+	"chain.syncTitle":   "正在与本地 devnet 同步 %s",
+	"chain.mempool":     "内存池：待处理 %s，排队 %s，基础费用 %s gwei",
+	"chain.synced":      "链头 %s，已最终确认 %s，%d 个对等节点",
+	"chain.deployTitle": "正在向本地 devnet 部署 %d 个合约（chain id %d）",
+	"chain.deployed":    "已部署 %d 个合约，共消耗 %s gas",
+
+	// 安全扫描
+	"sec.title":          "正在对 %s 进行安全扫描",
+	"sec.summaryTitle":   "严重级别汇总",
+	"sec.summary":        "安全扫描完成：%s，其中严重或高危 %d 个",
+	"sec.findings#other": "%s 个问题",
+
+	// 游戏引擎
+	"game.title":   "正在为 %[2]s 构建 %[1]s",
+	"game.cooked":  "已烘焙 %d 个资源：源文件 %s MB，打包后 %s MB",
+	"game.shaders": "共 %s 个着色器排列，%s 个复用派生数据缓存",
+	"game.hitch":   "第 %d 帧出现卡顿（%s ms），%s 耗时集中在 %s",
+	"game.fixed":   "已修复：%s",
+	"game.summary": "平均帧时间 %s ms（%s FPS），最差 %s ms",
+
+	// 日志
+	"logs.title":          "正在跟踪 %s 的日志（%s）",
+	"logs.summary":        "%[2]s 共输出 %[1]s：%[3]d 条警告，%[4]d 条错误",
+	"logs.lines#other":    "%s 行日志",
+	"logs.requests#other": "%s 个请求",
+
+	// 分布式追踪
+	"trace.title":   "追踪 %s：%s %s",
+	"trace.summary": "%d 个 span，涉及 %d 个服务，端到端耗时 %s ms，HTTP %d",
+
+	// 性能剖析
+	"prof.title":   "剖析 %s（%s）",
+	"prof.cpu":     "CPU 时间",
+	"prof.heap":    "在用堆内存",
+	"prof.flame":   "火焰图（%d 列）",
+	"prof.hotspot": "热点路径：%s 占 %[3]s 的 %[2]s",
+
+	// CI/CD 流水线
+	"ci.title":         "%s 流水线：%s（%s）",
+	"ci.retry":         "重试 %s（第 %d 次，共 %d 次）",
+	"ci.matrix":        "流水线状态",
+	"ci.passed":        "流水线 #%d 通过，耗时 %s：%s，%s",
+	"ci.failed":        "流水线 #%d 失败，耗时 %s：%s，%s",
+	"ci.jobs#other":    "%s 个任务",
+	"ci.retries#other": "%s 次重试",
+
+	// 代码评审
+	"review.title":             "正在评审 %[3]s 的 PR #%[1]d「%[2]s」",
+	"review.c.constant":        "nit：把这些魔法数字提取成具名常量，让上限在一个地方有文档说明",
+	"review.c.wrap":            "这里的错误需要加上上下文，否则统计 %s 失败和列出失败无法区分",
+	"review.c.count":           "对 %s 的 count(*) 在每次分页请求时都会全表扫描，能否缓存或者返回估算值？",
+	"review.c.timeout":         "%s 的超时能否从配置中读取，而不是写死？",
+	"review.c.retry":           "%s 短暂不可用时会怎样？对幂等读请求加上带退避的重试，可以避免半夜叫醒值班同事",
+	"review.approved":          "%s 批准了这些改动",
+	"review.changes":           "%s 要求修改",
+	"review.summary":           "评审完成：%s，%s",
+	"review.comments#other":    "%s 条评论",
+	"review.suggestions#other": "%s 条建议",
+
+	// 抓包
+	"pcap.title":         "正在抓取 %s 到 %s:%d 的 HTTPS 流量",
+	"pcap.decrypt":       "使用 %s 中的会话密钥解密 HTTP/2 流",
+	"pcap.summary":       "%s：TLS 1.3 握手耗时 %s 毫秒，%s %s 在 %[6]s 毫秒后返回 %[5]d",
+	"pcap.packets#other": "%s 个数据包",
+
+	// 运行时诊断
+	"rt.title":        "正在从 %s 收集 Go 运行时诊断信息",
+	"rt.heap":         "GC 后的存活堆在 %[3]s 中从 %[1]s MB 增长到 %[2]s MB",
+	"rt.cycles#other": "%s 轮 GC",
+	"rt.leak":         "发现泄漏：%s 个 goroutine 阻塞在 %s，等待一个永远不会被取消的 context",
+	"rt.linked":       "与静态分析报告的「%s」问题一致：",
+
+	// 压测
+	"lt.title":          "使用 %[2]s 对 %[1]s 压测 %[3]s",
+	"lt.live":           "%s：%s 请求/秒，p95 %s，错误率 %s",
+	"lt.buckets":        "延迟分布",
+	"lt.thresholds":     "阈值",
+	"lt.summary":        "%s，%s 请求/秒，p50 %s，p99 %s",
+	"lt.requests#other": "%s 个请求",
+	"lt.crossed":        "%[2]d 项阈值中通过 %[1]d 项",
+
+	// 依赖安装
+	"dep.title":            "正在使用 %[2]s 为 %[1]s 安装依赖",
+	"dep.summary":          "已解析 %s，去重 %s 条依赖声明，在 %[4]s 秒内下载 %[3]s MB",
+	"dep.packages#other":   "%s 个包",
+	"dep.mvs":              "最小版本选择从被要求的 %[3]s中为 %[1]s 选定 %[2]s",
+	"dep.versions#other":   "%s 个版本",
+	"dep.conflict":         "%s 的版本要求互不兼容，因此以下版本会同时保留：%s",
+	"dep.shared":           "%s 被 %s共用，只安装一次",
+	"dep.dependents#other": "%s 个包",
+
+	// 混沌实验
+	"chaos.title":             "正在 %[2]s 中运行混沌实验 %[1]s",
+	"chaos.hypothesis":        "假设：当%s时，%s 的 p99 保持在 %s ms 以下，错误率低于 %s",
+	"chaos.fault.kill":        " %s 的一个 Pod 被杀掉",
+	"chaos.fault.delay":       "向 %[2]s 注入 %[1]s ms 延迟",
+	"chaos.fault.partition":   " %s 与 %s 之间发生网络分区",
+	"chaos.steady":            "稳态：%s 请求/秒，p50 %s ms，p99 %s ms，错误率 %s",
+	"chaos.live":              "%s：%s 请求/秒，p99 %s ms，错误率 %s",
+	"chaos.abort":             "错误率超过 %s 的中止条件，暂停实验",
+	"chaos.graph.p99":         "%s 的 p99 延迟（每根柱子 %d 秒）",
+	"chaos.graph.errors":      "%s 的错误率（每根柱子 %d 秒）",
+	"chaos.passed":            "假设成立：最差 p99 %s ms，最高错误率 %s",
+	"chaos.failed":            "假设不成立：最差 p99 %s ms，最高错误率 %s",
+	"chaos.finding.kill":      "%s 没有重试发往被杀掉的 %s Pod 的在途请求",
+	"chaos.finding.delay":     "%[1]s 的客户端超时只有 %[2]s ms，%[3]s 变慢时没有余量",
+	"chaos.finding.partition": "%s 在 %s 不可达时没有降级方案，错误直接传给了调用方",
+}
@@ -0,0 +1,396 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// chaosWindowSeconds 指标采样窗口的长度
+const chaosWindowSeconds = 10
+
+// chaosEffect 故障在一个时间窗口内对调用方请求的影响
+type chaosEffect struct {
+	share      float64 // 调用到目标服务的请求比例
+	delay      float64 // 附加延迟（ms）
+	jitter     float64 // 附加延迟的抖动（ms）
+	slowdown   float64 // 剩余副本承压后的延迟倍数
+	failRate   float64 // 调用目标服务时直接失败的比例
+	failStatus int     // 直接失败时的状态码，504 表示等到超时才失败
+}
+
+// chaosWindow 生成调用方在一个窗口内的请求样本，超过客户端超时的请求记为 504
+func chaosWindow(m loadModel, rate int, e chaosEffect, timeout float64) []loadSample {
+	samples := make([]loadSample, rate*chaosWindowSeconds)
+	for i := range samples {
+		latency, status := m.sample()
+		ms := float64(latency) / float64(time.Millisecond)
+		if rand.Float64() < e.share {
+			ms = ms*math.Max(e.slowdown, 1) + math.Max(e.delay+e.jitter*rand.NormFloat64(), 0)
+			if rand.Float64() < e.failRate {
+				status = e.failStatus
+				if status != 504 {
+					ms = math.Min(ms, float64(rand.Intn(20)+2))
+				}
+			}
+		}
+		if status == 504 || ms > timeout {
+			ms, status = timeout, 504
+		}
+		samples[i] = loadSample{float64(i) / float64(rate), time.Duration(ms * float64(time.Millisecond)), status}
+	}
+	return samples
+}
+
+// chaosFault 一种可注入的故障
+type chaosFault struct {
+	kind     string // Chaos Mesh 的资源类型
+	action   string
+	manifest func(name, ns, target, caller string, delay int) string
+}
+
+// 可注入的故障
+var chaosFaults = []chaosFault{
+	{"PodChaos", "pod-kill", func(name, ns, target, _ string, _ int) string {
+		return fmt.Sprintf(`apiVersion: chaos-mesh.org/v1alpha1
+kind: PodChaos
+metadata:
+  name: %s
+  namespace: %s
+spec:
+  action: pod-kill
+  mode: one
+  selector:
+    namespaces: [%s]
+    labelSelectors:
+      app: %s
+`, name, ns, ns, target)
+	}},
+	{"NetworkChaos", "delay", func(name, ns, target, _ string, delay int) string {
+		return fmt.Sprintf(`apiVersion: chaos-mesh.org/v1alpha1
+kind: NetworkChaos
+metadata:
+  name: %s
+  namespace: %s
+spec:
+  action: delay
+  mode: all
+  selector:
+    namespaces: [%s]
+    labelSelectors:
+      app: %s
+  delay:
+    latency: "%dms"
+    jitter: "%dms"
+    correlation: "25"
+  duration: "60s"
+`, name, ns, ns, target, delay, delay/5)
+	}},
+	{"NetworkChaos", "partition", func(name, ns, target, caller string, _ int) string {
+		return fmt.Sprintf(`apiVersion: chaos-mesh.org/v1alpha1
+kind: NetworkChaos
+metadata:
+  name: %s
+  namespace: %s
+spec:
+  action: partition
+  mode: all
+  direction: both
+  selector:
+    namespaces: [%s]
+    labelSelectors:
+      app: %s
+  target:
+    mode: all
+    selector:
+      namespaces: [%s]
+      labelSelectors:
+        app: %s
+  duration: "60s"
+`, name, ns, ns, caller, ns, target)
+	}},
+}
+
+// chaosMetrics 一个窗口的调用方指标
+type chaosMetrics struct {
+	rps     float64
+	p99     float64 // ms
+	errRate float64 // 0..1
+}
+
+// measureWindow 统计一个窗口的样本
+func measureWindow(samples []loadSample) chaosMetrics {
+	s := newLoadStats(samples, 1, chaosWindowSeconds, 512)
+	return chaosMetrics{
+		rps:     s.rps(),
+		p99:     float64(s.percentile(99)) / float64(time.Millisecond),
+		errRate: s.errorRate(),
+	}
+}
+
+// printImpactGraph 按窗口画出指标柱状图：超过阈值的柱子标红，虚线是假设中的阈值，底部 ^ 标出故障注入的区间
+func printImpactGraph(title string, values []float64, limit float64, label func(float64) string, fault string, faultFrom, faultTo int) {
+	const height = 6
+	blocks := []string{" ", "▁", "▂", "▃", "▄", "▅", "▆", "▇", "█"}
+	top := limit * 1.25
+	for _, v := range values {
+		top = math.Max(top, v)
+	}
+	limitRow := int(math.Ceil(limit / top * height))
+
+	fmt.Println(title)
+	for row := height; row >= 1; row-- {
+		lo, hi := top*float64(row-1)/height, top*float64(row)/height
+		axis := fmt.Sprintf("%9s │", "")
+		switch row {
+		case height:
+			axis = fmt.Sprintf("%9s ┤", label(top))
+		case limitRow:
+			axis = fmt.Sprintf("%9s ┤", label(limit))
+		}
+		var b strings.Builder
+		for _, v := range values {
+			cell := "  "
+			switch {
+			case v >= hi:
+				cell = "██"
+			case v > lo:
+				cell = strings.Repeat(blocks[int((v-lo)/(hi-lo)*8)], 2)
+			case row == limitRow:
+				cell = "╌╌"
+			}
+			switch {
+			case cell == "╌╌":
+				cell = yellow(cell)
+			case v > limit:
+				cell = red(cell)
+			default:
+				cell = green(cell)
+			}
+			b.WriteString(cell + " ")
+		}
+		fmt.Println(axis + strings.TrimRight(b.String(), " "))
+	}
+	fmt.Printf("%9s └%s\n", "", strings.Repeat("───", len(values)))
+	marks := ""
+	for i := range values {
+		if i >= faultFrom && i < faultTo {
+			marks += "^^ "
+		} else {
+			marks += "   "
+		}
+	}
+	fmt.Printf("%9s  %s %s\n", "", strings.TrimRight(marks, " "), yellow(fault))
+	time.Sleep(time.Duration(rand.Intn(200)+200) * time.Millisecond)
+}
+
+// chaosVerdict 故障期间最差的指标以及它们是否突破了假设中的阈值
+type chaosVerdict struct {
+	worst                           chaosMetrics
+	latencyBreached, errorsBreached bool
+}
+
+// judgeHypothesis 用故障期间的窗口检验假设
+func judgeHypothesis(windows []chaosMetrics, p99Limit, errLimit float64) chaosVerdict {
+	var v chaosVerdict
+	for _, m := range windows {
+		v.worst.p99 = math.Max(v.worst.p99, m.p99)
+		v.worst.errRate = math.Max(v.worst.errRate, m.errRate)
+	}
+	v.latencyBreached, v.errorsBreached = v.worst.p99 > p99Limit, v.worst.errRate > errLimit
+	return v
+}
+
+// held 假设是否成立
+func (v chaosVerdict) held() bool {
+	return !v.latencyBreached && !v.errorsBreached
+}
+
+// alert 返回调用方应当触发的告警规则名，错误率超标优先于延迟超标；假设成立或未开启告警时返回空串
+func (v chaosVerdict) alert(callerDeploy string, alertsEnabled bool) string {
+	switch {
+	case v.held() || !alertsEnabled:
+		return ""
+	case v.errorsBreached:
+		return pascalCase(callerDeploy) + "HighErrorRate"
+	}
+	return pascalCase(callerDeploy) + "HighLatency"
+}
+
+// runChaosExperiment 模拟一次混沌实验：陈述假设、测量稳态、注入故障、观察影响、回滚并给出结论，假设不成立时触发告警
+func runChaosExperiment(config *SessionConfig) {
+	naming := config.naming
+	ns := naming.namespace
+	services := naming.randomServices(2)
+	caller, target := "gateway", services[0]
+	fault := chaosFaults[rand.Intn(len(chaosFaults))]
+	if fault.action == "partition" && len(services) > 1 && rand.Float32() < 0.5 {
+		caller = services[1]
+	}
+	callerDeploy, targetDeploy := naming.serviceName(caller), naming.serviceName(target)
+	name := fmt.Sprintf("%s-%s-%s", target, fault.action, kubeSuffix(4))
+
+	model := loadModel{
+		median:  float64(rand.Intn(30) + 12),
+		sigma:   0.3 + rand.Float64()*0.25,
+		tail:    0.002 + rand.Float64()*0.006,
+		errRate: rand.Float64() * 0.0008,
+	}
+	rate := []int{150, 300, 600}[rand.Intn(3)]
+	timeout := []float64{500, 1000, 2000}[rand.Intn(3)]
+	share := 0.3 + rand.Float64()*0.5
+	replicas := rand.Intn(3) + 2
+	delay := []int{50, 100, 200, 400}[rand.Intn(4)]
+	// 韧性措施决定了故障能否被吸收
+	retries := rand.Float32() < 0.5
+	fallback := rand.Float32() < 0.4
+
+	fmt.Println(blue("🧪 " + tr("chaos.title", name, ns)))
+
+	// 稳态：故障注入前一分钟的调用方指标，阈值由稳态推出
+	steadyWindows := 6
+	var series []chaosMetrics
+	var steadySamples []loadSample
+	for i := 0; i < steadyWindows; i++ {
+		w := chaosWindow(model, rate, chaosEffect{}, timeout)
+		steadySamples = append(steadySamples, w...)
+		series = append(series, measureWindow(w))
+	}
+	steady := newLoadStats(steadySamples, 1, float64(steadyWindows*chaosWindowSeconds), 512)
+	steadyP99 := float64(steady.percentile(99)) / float64(time.Millisecond)
+	p99Limit := math.Ceil((steadyP99*1.5+100)/50) * 50
+	errLimit := []float64{0.005, 0.01}[rand.Intn(2)]
+
+	var faultDesc string
+	switch fault.action {
+	case "pod-kill":
+		faultDesc = tr("chaos.fault.kill", targetDeploy)
+	case "delay":
+		faultDesc = tr("chaos.fault.delay", formatInt(delay), targetDeploy)
+	default:
+		faultDesc = tr("chaos.fault.partition", callerDeploy, targetDeploy)
+	}
+	fmt.Printf("💭 %s\n", tr("chaos.hypothesis", faultDesc, callerDeploy, formatInt(int(p99Limit)), formatPercentFloat(errLimit*100, 1)))
+	fmt.Printf("📏 %s\n", tr("chaos.steady", formatInt(int(steady.rps())),
+		formatFloat(float64(steady.percentile(50))/float64(time.Millisecond), 1), formatFloat(steadyP99, 1),
+		formatPercentFloat(steady.errorRate()*100, 2)))
+
+	// 注入故障
+	file := "chaos/" + name + ".yaml"
+	fmt.Println(blue("$ cat " + file))
+	fmt.Print(fault.manifest(name, ns, targetDeploy, callerDeploy, delay))
+	fmt.Println(blue("$ kubectl apply -f " + file))
+	fmt.Printf("%s.chaos-mesh.org/%s created\n", strings.ToLower(fault.kind), name)
+	time.Sleep(time.Duration(rand.Intn(300)+200) * time.Millisecond)
+
+	if fault.action == "pod-kill" {
+		fmt.Println(blue(fmt.Sprintf("$ kubectl -n %s get pods -l app=%s -w", ns, targetDeploy)))
+		rs := kubeSuffix(10)
+		pods := make([]string, replicas)
+		for i := range pods {
+			pods[i] = fmt.Sprintf("%s-%s-%s", targetDeploy, rs, kubeSuffix(5))
+		}
+		width := len(pods[0]) + 2
+		age := fmt.Sprintf("%dh", rand.Intn(70)+2)
+		fmt.Printf("%-*s %-7s %-18s %-10s %s\n", width, "NAME", "READY", "STATUS", "RESTARTS", "AGE")
+		for _, p := range pods {
+			printPodRow(width, kubePod{name: p, ready: "1/1", status: "Running", age: age})
+		}
+		victim := pods[rand.Intn(replicas)]
+		replacement := fmt.Sprintf("%s-%s-%s", targetDeploy, rs, kubeSuffix(5))
+		printPodRow(width, kubePod{name: victim, ready: "1/1", status: "Terminating", age: age})
+		printPodRow(width, kubePod{name: replacement, ready: "0/1", status: "ContainerCreating", age: "1s"})
+		printPodRow(width, kubePod{name: replacement, ready: "0/1", status: "Running", age: "6s"})
+		printPodRow(width, kubePod{name: replacement, ready: "1/1", status: "Running", age: fmt.Sprintf("%ds", rand.Intn(20)+14)})
+	}
+
+	// 故障期间逐窗口观察，错误率过高时提前中止实验
+	faultWindows, recoveryWindows := 6, 4
+	abortLimit := errLimit * 10
+	readyAfter := rand.Intn(2) + 2
+	faultEnd := steadyWindows
+	for i := 0; i < faultWindows; i++ {
+		var e chaosEffect
+		switch fault.action {
+		case "pod-kill":
+			// 被杀的 Pod 上的在途请求失败，新 Pod 就绪前剩余副本承担全部流量
+			if i < readyAfter {
+				e = chaosEffect{share: 1, slowdown: float64(replicas) / float64(replicas-1)}
+			}
+			if i == 0 {
+				e.failRate, e.failStatus = 0.25/float64(replicas), 502
+				if retries {
+					e.failRate /= 20
+				}
+			}
+		case "delay":
+			e = chaosEffect{share: share, delay: float64(delay), jitter: float64(delay) / 5}
+		default:
+			e = chaosEffect{share: share, failRate: 1, failStatus: 504}
+			if fallback {
+				// 降级到本地缓存，只有缓存未命中的请求失败
+				e = chaosEffect{share: share, delay: 2, failRate: 0.002 + rand.Float64()*0.01, failStatus: 503}
+			}
+		}
+		m := measureWindow(chaosWindow(model, rate, e, timeout))
+		series = append(series, m)
+		faultEnd++
+		line := "  ⏱ " + tr("chaos.live", fmt.Sprintf("+%ds", (i+1)*chaosWindowSeconds), formatInt(int(m.rps)),
+			formatFloat(m.p99, 0), formatPercentFloat(m.errRate*100, 2))
+		if m.p99 > p99Limit || m.errRate > errLimit {
+			line = red(line)
+		}
+		fmt.Println(line)
+		time.Sleep(time.Duration(rand.Intn(200)+200) * time.Millisecond)
+		if m.errRate > abortLimit {
+			fmt.Println(yellow("⛔ " + tr("chaos.abort", formatPercentFloat(abortLimit*100, 0))))
+			fmt.Println(blue(fmt.Sprintf("$ kubectl -n %s annotate %s %s experiment.chaos-mesh.org/pause=true", ns, strings.ToLower(fault.kind), name)))
+			fmt.Printf("%s.chaos-mesh.org/%s annotated\n", strings.ToLower(fault.kind), name)
+			break
+		}
+	}
+
+	// 回滚并确认恢复稳态
+	fmt.Println(blue("$ kubectl delete -f " + file))
+	fmt.Printf("%s.chaos-mesh.org \"%s\" deleted\n", strings.ToLower(fault.kind), name)
+	for i := 0; i < recoveryWindows; i++ {
+		series = append(series, measureWindow(chaosWindow(model, rate, chaosEffect{}, timeout)))
+	}
+
+	fmt.Println()
+	p99s, errs := make([]float64, len(series)), make([]float64, len(series))
+	for i, m := range series {
+		p99s[i], errs[i] = m.p99, m.errRate*100
+	}
+	printImpactGraph("📈 "+tr("chaos.graph.p99", callerDeploy, chaosWindowSeconds), p99s, p99Limit,
+		func(v float64) string { return formatInt(int(v)) + " ms" }, fault.action, steadyWindows, faultEnd)
+	printImpactGraph("📈 "+tr("chaos.graph.errors", callerDeploy, chaosWindowSeconds), errs, errLimit*100,
+		func(v float64) string { return formatPercentFloat(v, 1) }, fault.action, steadyWindows, faultEnd)
+
+	// 结论：只看故障期间的窗口，假设不成立时告警规则也应该被触发
+	verdict := judgeHypothesis(series[steadyWindows:faultEnd], p99Limit, errLimit)
+	worst := verdict.worst
+	if verdict.held() {
+		fmt.Println(green("✅ " + tr("chaos.passed", formatFloat(worst.p99, 0), formatPercentFloat(worst.errRate*100, 2))))
+	} else {
+		fmt.Println(red("❌ " + tr("chaos.failed", formatFloat(worst.p99, 0), formatPercentFloat(worst.errRate*100, 2))))
+		var finding string
+		switch fault.action {
+		case "pod-kill":
+			finding = tr("chaos.finding.kill", callerDeploy, targetDeploy)
+		case "delay":
+			finding = tr("chaos.finding.delay", callerDeploy, formatInt(int(timeout)), targetDeploy)
+		default:
+			finding = tr("chaos.finding.partition", callerDeploy, targetDeploy)
+		}
+		fmt.Printf("🔍 %s\n", finding)
+	}
+	if alert := verdict.alert(callerDeploy, config.alertsEnabled); alert != "" {
+		displayAlert(config, "🚨 "+tr("alert.chaos", alert, name))
+	}
+	if rand.Float32() < jargonDensity(config.jargonLevel) {
+		fmt.Printf("🧠 %s\n", tr("jargon.insight", generatePerformanceJargon(config.devType, config.jargonLevel)))
+	}
+}
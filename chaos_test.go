@@ -0,0 +1,31 @@
+package main
+
+import "testing"
+
+func TestChaosVerdictAlert(t *testing.T) {
+	const p99Limit, errLimit = 500, 0.01
+	tests := []struct {
+		name          string
+		windows       []chaosMetrics
+		alertsEnabled bool
+		wantHeld      bool
+		wantAlert     string
+	}{
+		{"held", []chaosMetrics{{p99: 320, errRate: 0.002}, {p99: 480, errRate: 0.008}}, true, true, ""},
+		{"latency breached", []chaosMetrics{{p99: 320, errRate: 0.002}, {p99: 2300, errRate: 0.004}}, true, false, "AcmeShopBillingHighLatency"},
+		{"errors breached", []chaosMetrics{{p99: 410, errRate: 0.21}, {p99: 380, errRate: 0.003}}, true, false, "AcmeShopBillingHighErrorRate"},
+		{"both breached", []chaosMetrics{{p99: 5000, errRate: 0.5}}, true, false, "AcmeShopBillingHighErrorRate"},
+		{"breached without alerts", []chaosMetrics{{p99: 5000, errRate: 0.5}}, false, false, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := judgeHypothesis(tt.windows, p99Limit, errLimit)
+			if v.held() != tt.wantHeld {
+				t.Errorf("held() = %v, want %v", v.held(), tt.wantHeld)
+			}
+			if got := v.alert("acme-shop-billing", tt.alertsEnabled); got != tt.wantAlert {
+				t.Errorf("alert() = %q, want %q", got, tt.wantAlert)
+			}
+		})
+	}
+}
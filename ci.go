@@ -0,0 +1,584 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// 流水线的展示风格
+var ciStyles = []string{"github", "gitlab", "jenkins"}
+
+// 各风格在界面上的名称
+var ciStyleNames = map[string]string{
+	"github":  "GitHub Actions",
+	"gitlab":  "GitLab CI",
+	"jenkins": "Jenkins",
+}
+
+// 流水线阶段，按执行顺序排列
+var ciStages = []string{"lint", "build", "test", "security", "deploy"}
+
+// 失败的任务最多尝试的次数
+const ciMaxAttempts = 2
+
+// ciToolchain 一种语言在流水线中使用的命令、构建矩阵和缓存
+// 命令和输出中的 {name}、{target}、{elapsed}、{files}、{version} 在生成任务时替换
+type ciToolchain struct {
+	lint        [][3]string // 任务名、命令和成功时的输出
+	matrix      []string    // 构建矩阵
+	build       string
+	built       string // 构建成功时的最后一行输出
+	artifact    string
+	cache       string // 缓存的目录
+	unit        string
+	integration string
+}
+
+var ciToolchains = map[string]ciToolchain{
+	"go": {
+		lint: [][3]string{
+			{"golangci-lint", "golangci-lint run --timeout 5m ./...", "0 issues."},
+			{"govulncheck", "govulncheck ./...", "No vulnerabilities found."},
+		},
+		matrix:      []string{"linux-amd64", "linux-arm64"},
+		build:       `go build -trimpath -ldflags="-s -w" -o bin/{name}-{target} ./cmd/{name}`,
+		artifact:    "bin/{name}-{target}",
+		cache:       "~/go/pkg/mod ~/.cache/go-build",
+		unit:        "go test -race -coverprofile=cover.out ./...",
+		integration: "go test -tags integration -run Integration ./...",
+	},
+	"rust": {
+		lint: [][3]string{
+			{"clippy", "cargo clippy --workspace --all-targets -- -D warnings", "    Finished `dev` profile [unoptimized + debuginfo] target(s) in {elapsed}"},
+			{"rustfmt", "cargo fmt --all --check", ""},
+		},
+		matrix:      []string{"x86_64-unknown-linux-gnu", "aarch64-unknown-linux-gnu"},
+		build:       "cargo build --release --locked --target {target}",
+		built:       "    Finished `release` profile [optimized] target(s) in {elapsed}",
+		artifact:    "target/{target}/release/{name}",
+		cache:       "~/.cargo/registry target",
+		unit:        "cargo test --workspace --locked",
+		integration: "cargo test --workspace --locked --test integration",
+	},
+	"typescript": {
+		lint: [][3]string{
+			{"eslint", "npx eslint . --max-warnings 0", ""},
+			{"typecheck", "npx tsc --noEmit", ""},
+		},
+		matrix:      []string{"node-20", "node-22"},
+		build:       "npm ci && npm run build",
+		built:       "✓ built in {elapsed}",
+		artifact:    "dist/",
+		cache:       "~/.npm",
+		unit:        "npx vitest run --coverage",
+		integration: "npx playwright test",
+	},
+	"python": {
+		lint: [][3]string{
+			{"ruff", "ruff check .", "All checks passed!"},
+			{"mypy", "mypy {name}", "Success: no issues found in {files} source files"},
+		},
+		matrix:      []string{"py3.11", "py3.12"},
+		build:       "python -m build",
+		built:       "Successfully built {name}-{version}.tar.gz and {name}-{version}-py3-none-any.whl",
+		artifact:    "dist/{name}-{version}-py3-none-any.whl",
+		cache:       "~/.cache/pip",
+		unit:        "pytest -q --cov={name}",
+		integration: "pytest -q -m integration",
+	},
+	"java": {
+		lint: [][3]string{
+			{"checkstyle", "./gradlew checkstyleMain spotbugsMain --no-daemon", "BUILD SUCCESSFUL in {elapsed}"},
+		},
+		matrix:      []string{"jdk-17", "jdk-21"},
+		build:       "./gradlew assemble --no-daemon",
+		built:       "BUILD SUCCESSFUL in {elapsed}",
+		artifact:    "build/libs/{name}-{version}.jar",
+		cache:       "~/.gradle/caches ~/.gradle/wrapper",
+		unit:        "./gradlew test --no-daemon",
+		integration: "./gradlew integrationTest --no-daemon",
+	},
+	"cpp": {
+		lint: [][3]string{
+			{"clang-format", "git ls-files '*.cpp' '*.h' | xargs clang-format --dry-run -Werror", ""},
+			{"clang-tidy", "run-clang-tidy -p build -quiet", ""},
+		},
+		matrix:      []string{"gcc-13", "clang-18"},
+		build:       "cmake -B build -DCMAKE_BUILD_TYPE=Release && cmake --build build --parallel",
+		built:       "[100%] Built target {name}",
+		artifact:    "build/bin/{name}",
+		cache:       "~/.ccache",
+		unit:        "ctest --test-dir build -L unit --output-on-failure",
+		integration: "ctest --test-dir build -L integration --output-on-failure",
+	},
+}
+
+// 安全扫描阶段的任务，与安全扫描活动使用同样的工具
+var ciSecurityJobs = [][3]string{
+	{"dependency-scan", "grype dir:. --only-fixed --fail-on high", "No vulnerabilities found"},
+	{"sast", "semgrep scan --config auto --sarif -o semgrep.sarif", "Ran {rules} rules on {files} files: 0 findings."},
+	{"secrets", "gitleaks detect --no-banner --redact", "INF no leaks found"},
+}
+
+// ciJob 流水线中的一个任务
+type ciJob struct {
+	stage    string
+	name     string
+	command  string
+	output   []string
+	cacheKey string // 为空表示不使用缓存
+	cacheHit bool
+	cache    string
+	artifact string
+	flaky    string // 第一次尝试失败的原因，重试后通过
+	failure  string // 所有尝试都失败的原因
+	skipped  bool
+	duration int // 秒，包含重试
+}
+
+// attempts 返回任务实际运行的次数
+func (j *ciJob) attempts() int {
+	switch {
+	case j.skipped:
+		return 0
+	case j.failure != "":
+		return ciMaxAttempts
+	case j.flaky != "":
+		return 2
+	}
+	return 1
+}
+
+// ciPipeline 一次流水线运行
+type ciPipeline struct {
+	style string
+	id    int
+	ref   string
+	sha   string
+	event string
+	jobs  []*ciJob
+}
+
+// stageJobs 返回某个阶段的任务
+func (p *ciPipeline) stageJobs(stage string) []*ciJob {
+	var jobs []*ciJob
+	for _, j := range p.jobs {
+		if j.stage == stage {
+			jobs = append(jobs, j)
+		}
+	}
+	return jobs
+}
+
+// failed 判断流水线是否有任务最终失败
+func (p *ciPipeline) failed() bool {
+	for _, j := range p.jobs {
+		if j.failure != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// ciTestSummary 按测试工具的格式生成测试通过时的最后一行输出
+func ciTestSummary(language, modulePath string, t testTotals) string {
+	passed := t.passed + t.flaky
+	switch language {
+	case "rust":
+		return fmt.Sprintf("test result: ok. %d passed; 0 failed; %d ignored; 0 measured; 0 filtered out; finished in %.2fs", passed, t.skipped, t.duration)
+	case "typescript", "vue":
+		return fmt.Sprintf("      Tests  %d passed | %d skipped (%d)", passed, t.skipped, passed+t.skipped)
+	case "python":
+		return fmt.Sprintf("%d passed, %d skipped in %.2fs", passed, t.skipped, t.duration)
+	case "java":
+		return fmt.Sprintf("BUILD SUCCESSFUL in %s", tfDuration(int(t.duration)+8))
+	case "cpp":
+		return fmt.Sprintf("100%% tests passed, 0 tests failed out of %d", passed)
+	}
+	return fmt.Sprintf("ok  \t%s/...\t%.3fs\tcoverage: %.1f%% of statements", modulePath, t.duration, t.coverage)
+}
+
+// ciDeployJob 根据开发类型生成部署任务
+func ciDeployJob(config *SessionConfig, env, sha string) *ciJob {
+	name := config.naming.name
+	job := &ciJob{stage: "deploy", name: "deploy-" + env, duration: rand.Intn(110) + 40}
+	switch config.devType {
+	case Frontend:
+		bucket := fmt.Sprintf("s3://%s-%s", name, env)
+		job.command = fmt.Sprintf("aws s3 sync dist/ %s --delete --cache-control max-age=31536000", bucket)
+		job.output = []string{
+			fmt.Sprintf("upload: dist/assets/index-%[1]s.js to %[2]s/assets/index-%[1]s.js", randomAssetHash(), bucket),
+			fmt.Sprintf("upload: dist/index.html to %s/index.html", bucket),
+		}
+	case GameDevelopment:
+		appID := rand.Intn(900000) + 1200000
+		job.command = "steamcmd +login ci_builder +run_app_build ../Build/app_build.vdf +quit"
+		job.output = []string{fmt.Sprintf("Successfully finished AppID %d build (BuildID %d).", appID, rand.Intn(9000000)+14000000)}
+	default:
+		job.command = fmt.Sprintf("helm upgrade --install %s deploy/chart -n %s --set image.tag=%s --atomic --wait", name, config.naming.namespace, sha)
+		job.output = []string{
+			fmt.Sprintf("Release %q has been upgraded. Happy Helming!", name),
+			fmt.Sprintf("NAMESPACE: %s", config.naming.namespace),
+			"STATUS: deployed",
+			fmt.Sprintf("REVISION: %d", rand.Intn(300)+20),
+		}
+	}
+	return job
+}
+
+// planPipeline 按仓库语言安排本次流水线的任务，并预先决定哪些任务需要重试或失败
+func planPipeline(config *SessionConfig) *ciPipeline {
+	g := config.git
+	p := &ciPipeline{style: ciStyles[rand.Intn(len(ciStyles))], id: rand.Intn(9000) + 1000, ref: g.branch, sha: g.mainSHA, event: "push"}
+	if p.ref == "" {
+		p.ref = "main"
+	} else {
+		if len(g.commits) > 0 {
+			p.sha = g.commits[len(g.commits)-1].sha
+		}
+		if g.prFor(p.ref) != nil {
+			p.event = "pull_request"
+		}
+	}
+
+	language := config.repo.language
+	tc, ok := ciToolchains[language]
+	if !ok {
+		// vue 项目沿用 typescript 的工具链
+		tc = ciToolchains["typescript"]
+	}
+	name := config.naming.name
+	if language == "python" {
+		name = config.naming.slug
+	}
+	files := len(config.repo.sourceFiles())
+	expand := func(s, target string, elapsed int) string {
+		return strings.NewReplacer("{name}", name, "{target}", target, "{elapsed}", tfDuration(elapsed),
+			"{files}", fmt.Sprint(files), "{version}", strings.TrimPrefix(config.naming.version, "v"),
+			"{rules}", fmt.Sprint(rand.Intn(900)+600)).Replace(s)
+	}
+	cacheKey := fmt.Sprintf("%s-Linux-%s", language, randomHex(16))
+	job := func(stage, jobName, command, output string, duration int) *ciJob {
+		j := &ciJob{stage: stage, name: jobName, command: expand(command, "", 0), duration: duration}
+		if output != "" {
+			j.output = []string{expand(output, "", duration)}
+		}
+		p.jobs = append(p.jobs, j)
+		return j
+	}
+
+	for _, l := range tc.lint {
+		job("lint", l[0], l[1], l[2], rand.Intn(70)+15)
+	}
+	for _, target := range tc.matrix {
+		j := job("build", fmt.Sprintf("build (%s)", target), "", "", 0)
+		j.command = expand(tc.build, target, 0)
+		j.cacheKey, j.cache, j.cacheHit = cacheKey, tc.cache, rand.Float32() < 0.7
+		j.duration = rand.Intn(80) + 40
+		if !j.cacheHit {
+			j.duration += rand.Intn(150) + 90
+		}
+		if tc.built != "" {
+			j.output = []string{expand(tc.built, target, j.duration-5)}
+		}
+		j.artifact = expand(tc.artifact, target, 0)
+	}
+
+	totals := summarizeTests(planTests(config.repo))
+	unit := job("test", "unit", tc.unit, ciTestSummary(language, config.naming.modulePath, totals), int(totals.duration)+rand.Intn(60)+30)
+	unit.cacheKey, unit.cache, unit.cacheHit = cacheKey, tc.cache, true
+	integration := job("test", "integration", tc.integration, "", rand.Intn(200)+90)
+	integration.output = []string{ciTestSummary(language, config.naming.modulePath, testTotals{
+		passed: rand.Intn(30) + 8, skipped: rand.Intn(3), duration: float64(integration.duration-20) + rand.Float64(), coverage: rand.Float64()*20 + 40,
+	})}
+
+	// 测试阶段偶尔不稳定，少数情况下重试也救不回来
+	tests := p.stageJobs("test")
+	if t := tests[rand.Intn(len(tests))]; rand.Float32() < 0.35 {
+		t.flaky = failureMessage(exportedName(singular(config.naming.randomService())))
+		t.duration *= 2
+	}
+	if t := tests[rand.Intn(len(tests))]; t.flaky == "" && rand.Float32() < 0.12 {
+		t.failure = failureMessage(exportedName(singular(config.naming.randomService())))
+		t.duration *= ciMaxAttempts
+	}
+
+	for _, s := range ciSecurityJobs {
+		j := job("security", s[0], s[1], s[2], rand.Intn(90)+25)
+		if s[0] == "sast" {
+			j.artifact = "semgrep.sarif"
+		}
+	}
+
+	env := "staging"
+	if p.ref == "main" {
+		env = "production"
+	}
+	p.jobs = append(p.jobs, ciDeployJob(config, env, p.sha))
+
+	// 前面的阶段失败后，后续阶段不再运行
+	failedAt := len(ciStages)
+	for i, stage := range ciStages {
+		for _, j := range p.stageJobs(stage) {
+			if i > failedAt {
+				j.skipped = true
+			} else if j.failure != "" {
+				failedAt = i
+			}
+		}
+	}
+	return p
+}
+
+// ciPause 流水线日志行之间的停顿
+func ciPause() {
+	time.Sleep(time.Duration(rand.Intn(90)+30) * time.Millisecond)
+}
+
+// printCIAttempt 打印一次执行的命令和输出，prompt 为该风格的命令提示符
+func printCIAttempt(style string, j *ciJob, prompt string, failure string) {
+	fmt.Println(prompt + j.command)
+	ciPause()
+	if failure == "" {
+		for _, line := range j.output {
+			fmt.Println(line)
+			ciPause()
+		}
+		return
+	}
+	fmt.Println(red("FAIL: " + failure))
+	switch style {
+	case "github":
+		fmt.Println(red("Error: Process completed with exit code 1."))
+	case "gitlab":
+		fmt.Println(red("ERROR: Job failed: exit code 1"))
+	default:
+		fmt.Println(red("ERROR: script returned exit code 1"))
+	}
+}
+
+// printCIAttempts 打印任务的所有尝试，失败后按重试策略再跑一次
+func printCIAttempts(style string, j *ciJob, prompt string) {
+	for attempt := 1; attempt <= j.attempts(); attempt++ {
+		failure := j.failure
+		if attempt == 1 && j.flaky != "" {
+			failure = j.flaky
+		}
+		if attempt > 1 {
+			fmt.Println(yellow("↻ " + tr("ci.retry", j.name, attempt, ciMaxAttempts)))
+		}
+		printCIAttempt(style, j, prompt, failure)
+	}
+}
+
+// printGitHubJob 按 GitHub Actions 的日志格式打印任务
+func printGitHubJob(j *ciJob) {
+	fmt.Println(blue(fmt.Sprintf("▶ %s / %s", j.stage, j.name)))
+	if j.cacheKey != "" {
+		fmt.Println("Run actions/cache@v4")
+		if j.cacheHit {
+			fmt.Printf("Cache restored from key: %s\n", j.cacheKey)
+		} else {
+			fmt.Printf("Cache not found for input keys: %s\n", j.cacheKey)
+		}
+	}
+	printCIAttempts("github", j, "Run ")
+	if j.failure != "" {
+		return
+	}
+	if j.artifact != "" {
+		fmt.Println("Run actions/upload-artifact@v4")
+		fmt.Printf("Artifact %s has been successfully uploaded! Final size is %d bytes. Artifact ID is %d\n",
+			strings.NewReplacer(" (", "-", ")", "").Replace(j.name), rand.Intn(40<<20)+(1<<20), rand.Intn(900000000)+1000000000)
+	}
+	if j.cacheKey != "" && !j.cacheHit {
+		fmt.Println("Post Run actions/cache@v4")
+		fmt.Printf("Cache saved with key: %s\n", j.cacheKey)
+	}
+}
+
+// printGitLabJob 按 GitLab Runner 的日志格式打印任务
+func printGitLabJob(j *ciJob) {
+	fmt.Println(blue(fmt.Sprintf("▶ %s: %s", j.stage, j.name)))
+	if j.cacheKey != "" {
+		fmt.Println("Restoring cache")
+		fmt.Printf("Checking cache for %s-protected...\n", j.cacheKey)
+		if j.cacheHit {
+			fmt.Println("Successfully extracted cache")
+		} else {
+			fmt.Println(yellow("WARNING: file does not exist"))
+			fmt.Println(yellow("Failed to extract cache"))
+		}
+	}
+	fmt.Println(`Executing "step_script" stage of the job script`)
+	printCIAttempts("gitlab", j, "$ ")
+	if j.failure != "" {
+		return
+	}
+	if j.cacheKey != "" && !j.cacheHit {
+		fmt.Println("Saving cache for successful job")
+		fmt.Printf("%s: found %d matching artifact files and directories\n", strings.Fields(j.cache)[0], rand.Intn(9000)+500)
+		fmt.Println("Created cache")
+	}
+	if j.artifact != "" {
+		fmt.Println("Uploading artifacts for successful job")
+		fmt.Printf("%s: found 1 matching artifact files and directories\n", j.artifact)
+		fmt.Printf("Uploading artifacts as \"archive\" to coordinator... 201 Created  id=%d responseStatus=201 Created\n", rand.Int63n(90000000)+7000000000)
+	}
+	fmt.Println(green("Job succeeded"))
+}
+
+// printJenkinsJob 按 Jenkins 控制台的格式打印任务，parallel 表示任务是并行分支之一
+func printJenkinsJob(j *ciJob, parallel bool) {
+	if parallel {
+		fmt.Printf("[Pipeline] { (Branch: %s)\n", j.name)
+	}
+	if j.cacheKey != "" {
+		fmt.Println("[Pipeline] cache")
+		if j.cacheHit {
+			fmt.Printf("[Cache for %s with id %s] Searching cache in job specific caches...\n", j.cache, j.cacheKey)
+			fmt.Printf("[Cache for %s with id %s] Found cache in job specific caches\n", j.cache, j.cacheKey)
+		} else {
+			fmt.Printf("[Cache for %s with id %s] Skip restoring cache as no up-to-date cache exists\n", j.cache, j.cacheKey)
+		}
+	}
+	fmt.Println("[Pipeline] sh")
+	printCIAttempts("jenkins", j, "+ ")
+	if j.artifact != "" && j.failure == "" {
+		fmt.Println("[Pipeline] archiveArtifacts")
+		fmt.Println("Archiving artifacts")
+	}
+	if parallel {
+		fmt.Println("[Pipeline] }")
+	}
+}
+
+// printCIMatrix 以阶段为列打印所有任务的最终状态
+func printCIMatrix(p *ciPipeline) {
+	columns := make([][]string, len(ciStages))
+	colors := make([][]func(a ...interface{}) string, len(ciStages))
+	widths := make([]int, len(ciStages))
+	rows := 0
+	for i, stage := range ciStages {
+		widths[i] = len(stage)
+		for _, j := range p.stageJobs(stage) {
+			cell, color := "○ "+j.name, fmt.Sprint
+			switch {
+			case j.skipped:
+			case j.failure != "":
+				cell, color = "✗ "+j.name+" "+tfDuration(j.duration), red
+			case j.flaky != "":
+				cell, color = "↻ "+j.name+" "+tfDuration(j.duration), yellow
+			default:
+				cell, color = "✓ "+j.name+" "+tfDuration(j.duration), green
+			}
+			columns[i] = append(columns[i], cell)
+			colors[i] = append(colors[i], color)
+			widths[i] = max(widths[i], len([]rune(cell)))
+		}
+		rows = max(rows, len(columns[i]))
+	}
+
+	var header []string
+	for i, stage := range ciStages {
+		header = append(header, fmt.Sprintf("%-*s", widths[i], stage))
+	}
+	fmt.Println(strings.TrimRight("  "+strings.Join(header, " │ "), " "))
+	for r := 0; r < rows; r++ {
+		var cells []string
+		for i := range ciStages {
+			cell, color := "", fmt.Sprint
+			if r < len(columns[i]) {
+				cell, color = columns[i][r], colors[i][r]
+			}
+			if i < len(ciStages)-1 {
+				cell += strings.Repeat(" ", widths[i]-len([]rune(cell)))
+			}
+			cells = append(cells, color(cell))
+		}
+		fmt.Println("  " + strings.Join(cells, " │ "))
+	}
+}
+
+// runCIPipeline 模拟一次 CI/CD 流水线运行，风格随机取 GitHub Actions、GitLab CI 或 Jenkins
+func runCIPipeline(config *SessionConfig) {
+	p := planPipeline(config)
+	fmt.Println(blue("🚦 " + tr("ci.title", ciStyleNames[p.style], p.ref, p.sha)))
+
+	switch p.style {
+	case "github":
+		fmt.Printf("$ gh run watch %d\n", p.id)
+		fmt.Printf("Triggered via %s · %s · %s\n", p.event, p.ref, p.sha)
+	case "gitlab":
+		fmt.Printf("Pipeline #%d for %s (%s)\n", p.id, p.ref, p.sha)
+		fmt.Printf("Running with gitlab-runner 17.5.2 (c6ea7d4b)\n  on %s-runner-%s, system ID: s_%s\n", config.naming.org, kubeSuffix(8), randomHex(12))
+	default:
+		fmt.Printf("Started by %s event for %s\n", p.event, p.ref)
+		fmt.Printf("Obtained Jenkinsfile from git %s\n", strings.TrimPrefix(config.git.upstream, "git@"))
+		fmt.Println("[Pipeline] Start of Pipeline")
+		fmt.Printf("Running on agent-%s in /home/jenkins/workspace/%s_%s\n", kubeSuffix(5), config.naming.name, strings.ReplaceAll(p.ref, "/", "_"))
+	}
+
+	total, retries := 0, 0
+	for _, stage := range ciStages {
+		jobs := p.stageJobs(stage)
+		if p.style == "jenkins" {
+			fmt.Printf("[Pipeline] stage\n[Pipeline] { (%s)\n", exportedName(stage))
+		}
+		if jobs[0].skipped {
+			if p.style == "jenkins" {
+				fmt.Printf("Stage \"%s\" skipped due to earlier failure(s)\n[Pipeline] }\n[Pipeline] // stage\n", exportedName(stage))
+			}
+			continue
+		}
+		if p.style == "jenkins" && len(jobs) > 1 {
+			fmt.Println("[Pipeline] parallel")
+		}
+		// 同一阶段的任务并行执行，阶段耗时取最慢的任务
+		longest := 0
+		for _, j := range jobs {
+			switch p.style {
+			case "github":
+				printGitHubJob(j)
+			case "gitlab":
+				printGitLabJob(j)
+			default:
+				printJenkinsJob(j, len(jobs) > 1)
+			}
+			longest = max(longest, j.duration)
+			retries += j.attempts() - 1
+		}
+		total += longest
+		if p.style == "jenkins" {
+			if len(jobs) > 1 {
+				fmt.Println("[Pipeline] // parallel")
+			}
+			fmt.Println("[Pipeline] }\n[Pipeline] // stage")
+		}
+	}
+
+	status := "success"
+	if p.failed() {
+		status = "failure"
+	}
+	switch p.style {
+	case "github":
+		fmt.Printf("Run CI (%d) completed with '%s'\n", p.id, status)
+	case "jenkins":
+		fmt.Println("[Pipeline] End of Pipeline")
+		fmt.Printf("Finished: %s\n", strings.ToUpper(status))
+	}
+
+	fmt.Printf("\n📋 %s\n", tr("ci.matrix"))
+	printCIMatrix(p)
+	if p.failed() {
+		fmt.Printf("❌ %s\n", tr("ci.failed", p.id, tfDuration(total), trn("ci.jobs", len(p.jobs)), trn("ci.retries", retries)))
+	} else {
+		fmt.Printf("✅ %s\n", tr("ci.passed", p.id, tfDuration(total), trn("ci.jobs", len(p.jobs)), trn("ci.retries", retries)))
+	}
+	if rand.Float32() < jargonDensity(config.jargonLevel) {
+		fmt.Printf("🧠 %s\n", tr("jargon.insight", generateCodeJargon(config.devType, config.jargonLevel)))
+	}
+}
@@ -0,0 +1,289 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// migration 一次待执行的数据库迁移
+type migration struct {
+	version    string
+	name       string
+	statements []migrationStatement
+}
+
+// migrationStatement 迁移中的一条 DDL/DML 及其执行结果
+type migrationStatement struct {
+	sql    string
+	tag    string // psql 风格的命令标签，如 CREATE INDEX、UPDATE 48213
+	millis float64
+}
+
+// 迁移中常见的列及其类型
+var migrationColumns = [][2]string{
+	{"priority", "smallint NOT NULL DEFAULT 0"},
+	{"tenant_id", "uuid"},
+	{"external_ref", "varchar(64)"},
+	{"archived_at", "timestamptz"},
+	{"retry_count", "integer NOT NULL DEFAULT 0"},
+	{"metadata", "jsonb NOT NULL DEFAULT '{}'::jsonb"},
+}
+
+// queryPlan 一组优化前后的执行计划
+type queryPlan struct {
+	sql    string
+	before []string
+	after  []string
+	slowMs float64
+	fastMs float64
+}
+
+// pgTiming 生成 EXPLAIN ANALYZE 中的 "actual time=a..b rows=n loops=1"
+func pgTiming(start, end float64, rows int) string {
+	return fmt.Sprintf("(actual time=%.3f..%.3f rows=%d loops=1)", start, end, rows)
+}
+
+// pgCost 生成 EXPLAIN 中的 "cost=a..b rows=n width=w"
+func pgCost(start, end float64, rows, width int) string {
+	return fmt.Sprintf("(cost=%.2f..%.2f rows=%d width=%d)", start, end, rows, width)
+}
+
+// planFilterSort 按客户过滤并排序的查询：顺序扫描 + 排序 对比 复合索引扫描
+func planFilterSort(table string, index string) queryPlan {
+	total := rand.Intn(4000000) + 500000
+	matched := rand.Intn(8000) + 800
+	customer := rand.Intn(90000) + 1000
+	slow := float64(total) * (rand.Float64()*0.0002 + 0.0003)
+	fast := rand.Float64()*0.4 + 0.1
+	seqCost := float64(total) * 0.024
+	width := rand.Intn(120) + 80
+
+	return queryPlan{
+		sql: fmt.Sprintf("SELECT * FROM %s WHERE customer_id = %d AND status = 'pending' ORDER BY created_at DESC LIMIT 50;", table, customer),
+		before: []string{
+			fmt.Sprintf("Limit  %s %s", pgCost(seqCost+157, seqCost+157.13, 50, width), pgTiming(slow-0.05, slow-0.03, 50)),
+			fmt.Sprintf("  ->  Sort  %s %s", pgCost(seqCost+157, seqCost+169.4, matched, width), pgTiming(slow-0.06, slow-0.04, 50)),
+			"        Sort Key: created_at DESC",
+			fmt.Sprintf("        Sort Method: top-N heapsort  Memory: %dkB", rand.Intn(40)+25),
+			fmt.Sprintf("        ->  Seq Scan on %s  %s %s", table, pgCost(0, seqCost, matched, width), pgTiming(0.021, slow-3.2, matched)),
+			fmt.Sprintf("              Filter: ((customer_id = %d) AND (status = 'pending'::text))", customer),
+			fmt.Sprintf("              Rows Removed by Filter: %d", total-matched),
+		},
+		after: []string{
+			fmt.Sprintf("Limit  %s %s", pgCost(0.56, 52.83, 50, width), pgTiming(0.041, fast-0.03, 50)),
+			fmt.Sprintf("  ->  Index Scan using %s on %s  %s %s", index, table, pgCost(0.56, float64(matched)*1.04, matched, width), pgTiming(0.039, fast-0.04, 50)),
+			fmt.Sprintf("        Index Cond: ((customer_id = %d) AND (status = 'pending'::text))", customer),
+		},
+		slowMs: slow,
+		fastMs: fast,
+	}
+}
+
+// planJoinAggregate 按时间窗口聚合的连接查询：哈希连接 对比 索引嵌套循环
+func planJoinAggregate(table, child, fk, index string) queryPlan {
+	parents := rand.Intn(2000000) + 300000
+	children := parents * (rand.Intn(4) + 3)
+	recent := parents / (rand.Intn(40) + 20)
+	groups := recent / (rand.Intn(5) + 2)
+	slow := float64(children) * (rand.Float64()*0.00015 + 0.0002)
+	fast := float64(recent) * (rand.Float64()*0.002 + 0.002)
+	batches := 1 << (rand.Intn(3) + 1)
+
+	return queryPlan{
+		sql: fmt.Sprintf("SELECT p.customer_id, count(*) FROM %s p JOIN %s c ON c.%s = p.id WHERE p.created_at >= now() - interval '7 days' GROUP BY p.customer_id;", table, child, fk),
+		before: []string{
+			fmt.Sprintf("HashAggregate  %s %s", pgCost(float64(children)*0.05, float64(children)*0.052, groups, 16), pgTiming(slow-12.4, slow-2.1, groups)),
+			"  Group Key: p.customer_id",
+			fmt.Sprintf("  ->  Hash Join  %s %s", pgCost(float64(parents)*0.03, float64(children)*0.048, recent*4, 8), pgTiming(slow*0.2, slow-14.8, recent*4)),
+			fmt.Sprintf("        Hash Cond: (c.%s = p.id)", fk),
+			fmt.Sprintf("        ->  Seq Scan on %s c  %s %s", child, pgCost(0, float64(children)*0.018, children, 8), pgTiming(0.012, slow*0.45, children)),
+			fmt.Sprintf("        ->  Hash  %s %s", pgCost(float64(parents)*0.028, float64(parents)*0.028, recent, 16), pgTiming(slow*0.19, slow*0.19, recent)),
+			fmt.Sprintf("              Buckets: 65536  Batches: %d  Memory Usage: %dkB", batches, rand.Intn(3000)+1024),
+			fmt.Sprintf("              ->  Seq Scan on %s p  %s %s", table, pgCost(0, float64(parents)*0.027, recent, 16), pgTiming(0.018, slow*0.18, recent)),
+			"                    Filter: (created_at >= (now() - '7 days'::interval))",
+			fmt.Sprintf("                    Rows Removed by Filter: %d", parents-recent),
+		},
+		after: []string{
+			fmt.Sprintf("HashAggregate  %s %s", pgCost(float64(recent)*0.9, float64(recent)*0.92, groups, 16), pgTiming(fast*0.9, fast*0.97, groups)),
+			"  Group Key: p.customer_id",
+			fmt.Sprintf("  ->  Nested Loop  %s %s", pgCost(0.86, float64(recent)*0.8, recent*4, 8), pgTiming(0.031, fast*0.8, recent*4)),
+			fmt.Sprintf("        ->  Index Scan using idx_%s_created_at on %s p  %s %s", table, table, pgCost(0.43, float64(recent)*0.11, recent, 16), pgTiming(0.014, fast*0.1, recent)),
+			"              Index Cond: (created_at >= (now() - '7 days'::interval))",
+			fmt.Sprintf("        ->  Index Only Scan using %s on %s c  %s %s", index, child, pgCost(0.43, 0.61, 4, 8), pgTiming(0.002, 0.003, 4)),
+			fmt.Sprintf("              Index Cond: (%s = p.id)", fk),
+			"              Heap Fetches: 0",
+		},
+		slowMs: slow,
+		fastMs: fast,
+	}
+}
+
+// printPlan 打印 EXPLAIN ANALYZE 的结果
+func printPlan(lines []string, ms float64) {
+	for _, l := range lines {
+		fmt.Println("  " + l)
+		time.Sleep(time.Duration(rand.Intn(60)+20) * time.Millisecond)
+	}
+	fmt.Printf("  Planning Time: %.3f ms\n", rand.Float64()*0.4+0.1)
+	fmt.Printf("  Execution Time: %.3f ms\n", ms)
+}
+
+// planMigrations 生成本次待执行的迁移，第一条总是为慢查询补索引
+func planMigrations(table, indexSQL, index string) []migration {
+	stamp := time.Now().Add(-time.Duration(rand.Intn(72)) * time.Hour)
+	next := func() string {
+		stamp = stamp.Add(time.Duration(rand.Intn(3000)+60) * time.Second)
+		return stamp.Format("20060102150405")
+	}
+
+	migrations := []migration{{
+		version: next(),
+		name:    "add_" + index,
+		statements: []migrationStatement{
+			{sql: indexSQL, tag: "CREATE INDEX", millis: rand.Float64()*4000 + 800},
+		},
+	}}
+
+	col := migrationColumns[rand.Intn(len(migrationColumns))]
+	migrations = append(migrations, migration{
+		version: next(),
+		name:    fmt.Sprintf("add_%s_to_%s", col[0], table),
+		statements: []migrationStatement{
+			{sql: fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, col[0], col[1]), tag: "ALTER TABLE", millis: rand.Float64()*20 + 2},
+			{sql: fmt.Sprintf("UPDATE %s SET %s = DEFAULT WHERE %s IS NULL", table, col[0], col[0]),
+				tag: fmt.Sprintf("UPDATE %d", rand.Intn(900000)+1000), millis: rand.Float64()*3000 + 200},
+		},
+	})
+
+	if rand.Float32() < 0.5 {
+		audit := singular(table) + "_audit"
+		migrations = append(migrations, migration{
+			version: next(),
+			name:    "create_" + audit,
+			statements: []migrationStatement{
+				{sql: fmt.Sprintf("CREATE TABLE %s (id bigserial PRIMARY KEY, %s_id bigint NOT NULL REFERENCES %s (id), changed_at timestamptz NOT NULL DEFAULT now(), diff jsonb)",
+					audit, singular(table), table), tag: "CREATE TABLE", millis: rand.Float64()*15 + 3},
+			},
+		})
+	}
+	return migrations
+}
+
+// printMigrations 按仓库语言常用的迁移工具格式打印迁移过程
+func printMigrations(language string, naming *ProjectNaming, dbName string, migrations []migration) {
+	dsn := fmt.Sprintf("postgres://%s@db.%s.svc:5432/%s", naming.slug, naming.namespace, dbName)
+	switch language {
+	case "python":
+		fmt.Println("$ alembic upgrade head")
+		fmt.Println("INFO  [alembic.runtime.migration] Context impl PostgresqlImpl.")
+		fmt.Println("INFO  [alembic.runtime.migration] Will assume transactional DDL.")
+	case "java":
+		fmt.Println("$ ./gradlew flywayMigrate")
+		fmt.Printf("Successfully validated %d migrations\n", len(migrations)+rand.Intn(40)+10)
+		fmt.Printf("Current version of schema \"public\": %d\n", rand.Intn(40)+10)
+	case "rust":
+		fmt.Printf("$ sqlx migrate run --database-url %s\n", dsn)
+	case "typescript", "vue":
+		fmt.Println("$ npx prisma migrate deploy")
+		fmt.Printf("Datasource \"db\": PostgreSQL database \"%s\", schema \"public\" at \"db.%s.svc:5432\"\n", dbName, naming.namespace)
+		fmt.Printf("%d migrations found in prisma/migrations\n", len(migrations)+rand.Intn(30)+5)
+	default:
+		fmt.Printf("$ migrate -path db/migrations -database %s up\n", dsn)
+	}
+
+	prevRevision := randomHex(12)
+	flywayVersion := rand.Intn(40) + 11
+	for _, m := range migrations {
+		total := 0.0
+		for _, s := range m.statements {
+			total += s.millis
+		}
+		label := strings.ReplaceAll(m.name, "_", " ")
+		switch language {
+		case "python":
+			revision := randomHex(12)
+			fmt.Printf("INFO  [alembic.runtime.migration] Running upgrade %s -> %s, %s\n", prevRevision, revision, label)
+			prevRevision = revision
+		case "java":
+			fmt.Printf("Migrating schema \"public\" to version \"%d - %s\"\n", flywayVersion, label)
+			flywayVersion++
+		case "typescript", "vue":
+			fmt.Printf("Applying migration `%s_%s`\n", m.version, m.name)
+		}
+		for _, s := range m.statements {
+			time.Sleep(time.Duration(rand.Intn(200)+100) * time.Millisecond)
+			fmt.Printf("  %s\n", blue(s.sql+";"))
+			fmt.Printf("  %s (%s)\n", s.tag, formatMillis(s.millis))
+		}
+		switch language {
+		case "rust":
+			fmt.Printf("Applied %s/migrate %s (%s)\n", m.version, label, formatMillis(total))
+		case "python", "java", "typescript", "vue":
+		default:
+			fmt.Printf("%s/u %s (%s)\n", m.version, m.name, formatMillis(total))
+		}
+	}
+	switch language {
+	case "java":
+		fmt.Printf("Successfully applied %d migrations to schema \"public\", now at version v%d\n", len(migrations), flywayVersion-1)
+	case "typescript", "vue":
+		fmt.Println("All migrations have been successfully applied.")
+	}
+}
+
+// formatMillis 以 Go 的 time.Duration 风格显示毫秒数
+func formatMillis(ms float64) string {
+	if ms >= 1000 {
+		return fmt.Sprintf("%.2fs", ms/1000)
+	}
+	return fmt.Sprintf("%.1fms", ms)
+}
+
+// runDatabaseMigration 模拟一次发现慢查询、补索引迁移、对比执行计划的过程
+func runDatabaseMigration(config *SessionConfig) {
+	naming := config.naming
+	svc := naming.randomService()
+	table := strings.ReplaceAll(svc, "-", "_")
+	dbName := naming.databaseName(svc)
+	fmt.Println(blue("🗄️  " + tr("db.title", dbName)))
+
+	// alembic、prisma 和 sqlx 默认在事务中执行迁移，而 PostgreSQL 不允许在事务中 CREATE INDEX CONCURRENTLY
+	concurrently := " CONCURRENTLY"
+	switch config.repo.language {
+	case "python", "typescript", "vue", "rust":
+		concurrently = ""
+	}
+
+	// 慢查询
+	var plan queryPlan
+	var index, indexSQL string
+	if rand.Float32() < 0.5 {
+		index = fmt.Sprintf("idx_%s_customer_id_status_created_at", table)
+		indexSQL = fmt.Sprintf("CREATE INDEX%s %s ON %s (customer_id, status, created_at DESC)", concurrently, index, table)
+		plan = planFilterSort(table, index)
+	} else {
+		child := singular(table) + "_events"
+		fk := singular(table) + "_id"
+		index = fmt.Sprintf("idx_%s_%s", child, fk)
+		indexSQL = fmt.Sprintf("CREATE INDEX%s %s ON %s (%s)", concurrently, index, child, fk)
+		plan = planJoinAggregate(table, child, fk, index)
+	}
+	fmt.Printf("🐢 %s\n", yellow(tr("db.slowQuery", formatFloat(plan.slowMs, 1))))
+	fmt.Printf("%s=# EXPLAIN ANALYZE %s\n", dbName, plan.sql)
+	printPlan(plan.before, plan.slowMs)
+
+	// 迁移
+	fmt.Printf("\n🔧 %s\n", tr("db.optimizing"))
+	migrations := planMigrations(table, indexSQL, index)
+	printMigrations(config.repo.language, naming, dbName, migrations)
+
+	// 优化后的执行计划
+	fmt.Printf("\n%s=# EXPLAIN ANALYZE %s\n", dbName, plan.sql)
+	printPlan(plan.after, plan.fastMs)
+
+	fmt.Printf("\n⚡ %s\n", green(tr("db.improved", formatFloat(plan.slowMs, 1), formatFloat(plan.fastMs, 3),
+		formatInt(int(plan.slowMs/plan.fastMs)))))
+	fmt.Printf("📦 %s\n", trn("db.migrations", len(migrations)))
+}
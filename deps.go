@@ -0,0 +1,114 @@
+package main
+
+// dependency 虚拟仓库使用的第三方依赖
+type dependency struct {
+	name    string
+	version string
+	dev     bool // 仅开发/测试时使用
+}
+
+// 各语言的第三方依赖目录
+var thirdPartyDeps = map[string][]dependency{
+	"go": {
+		{name: "github.com/go-chi/chi/v5", version: "v5.1.0"},
+		{name: "github.com/jackc/pgx/v5", version: "v5.6.0"},
+		{name: "github.com/redis/go-redis/v9", version: "v9.6.1"},
+		{name: "go.uber.org/zap", version: "v1.27.0"},
+		{name: "go.opentelemetry.io/otel", version: "v1.28.0"},
+		{name: "google.golang.org/grpc", version: "v1.65.0"},
+		{name: "google.golang.org/protobuf", version: "v1.34.2"},
+		{name: "github.com/prometheus/client_golang", version: "v1.19.1"},
+		{name: "github.com/spf13/viper", version: "v1.19.0"},
+		{name: "golang.org/x/sync", version: "v0.8.0"},
+		{name: "golang.org/x/net", version: "v0.27.0"},
+		{name: "github.com/stretchr/testify", version: "v1.9.0", dev: true},
+	},
+	"rust": {
+		{name: "proc-macro2", version: "1.0.86"},
+		{name: "quote", version: "1.0.36"},
+		{name: "syn", version: "2.0.72"},
+		{name: "libc", version: "0.2.155"},
+		{name: "bytes", version: "1.6.1"},
+		{name: "serde", version: "1.0.204"},
+		{name: "serde_json", version: "1.0.120"},
+		{name: "thiserror", version: "1.0.63"},
+		{name: "anyhow", version: "1.0.86"},
+		{name: "tracing", version: "0.1.40"},
+		{name: "parking_lot", version: "0.12.3"},
+		{name: "futures", version: "0.3.30"},
+		{name: "tokio", version: "1.39.2"},
+		{name: "hyper", version: "1.4.1"},
+		{name: "tower", version: "0.4.13"},
+		{name: "axum", version: "0.7.5"},
+		{name: "sqlx", version: "0.8.0"},
+		{name: "clap", version: "4.5.11"},
+		{name: "proptest", version: "1.5.0", dev: true},
+	},
+	"typescript": {
+		{name: "react", version: "18.3.1"},
+		{name: "react-dom", version: "18.3.1"},
+		{name: "@tanstack/react-query", version: "5.51.11"},
+		{name: "zustand", version: "4.5.4"},
+		{name: "axios", version: "1.7.2"},
+		{name: "zod", version: "3.23.8"},
+		{name: "date-fns", version: "3.6.0"},
+		{name: "typescript", version: "5.5.4", dev: true},
+		{name: "vite", version: "5.3.5", dev: true},
+		{name: "vitest", version: "2.0.4", dev: true},
+		{name: "eslint", version: "9.8.0", dev: true},
+		{name: "@testing-library/react", version: "16.0.0", dev: true},
+	},
+	"vue": {
+		{name: "vue", version: "3.4.34"},
+		{name: "vue-router", version: "4.4.0"},
+		{name: "pinia", version: "2.2.0"},
+		{name: "axios", version: "1.7.2"},
+		{name: "zod", version: "3.23.8"},
+		{name: "typescript", version: "5.5.4", dev: true},
+		{name: "vite", version: "5.3.5", dev: true},
+		{name: "vitest", version: "2.0.4", dev: true},
+		{name: "eslint-plugin-vue", version: "9.27.0", dev: true},
+	},
+	"python": {
+		{name: "numpy", version: "1.26.4"},
+		{name: "pandas", version: "2.2.2"},
+		{name: "scikit-learn", version: "1.5.1"},
+		{name: "pydantic", version: "2.8.2"},
+		{name: "fastapi", version: "0.111.1"},
+		{name: "sqlalchemy", version: "2.0.31"},
+		{name: "httpx", version: "0.27.0"},
+		{name: "tqdm", version: "4.66.4"},
+		{name: "pytest", version: "8.3.2", dev: true},
+		{name: "ruff", version: "0.5.5", dev: true},
+	},
+	"java": {
+		{name: "org.springframework.boot:spring-boot-starter-web", version: "3.3.2"},
+		{name: "org.springframework.boot:spring-boot-starter-data-jpa", version: "3.3.2"},
+		{name: "com.fasterxml.jackson.core:jackson-databind", version: "2.17.2"},
+		{name: "org.postgresql:postgresql", version: "42.7.3"},
+		{name: "com.google.guava:guava", version: "33.2.1-jre"},
+		{name: "io.micrometer:micrometer-registry-prometheus", version: "1.13.2"},
+		{name: "org.junit.jupiter:junit-jupiter", version: "5.10.3", dev: true},
+		{name: "org.mockito:mockito-core", version: "5.12.0", dev: true},
+	},
+	"cpp": {
+		{name: "fmt", version: "10.2.1"},
+		{name: "spdlog", version: "1.14.1"},
+		{name: "glm", version: "1.0.1"},
+		{name: "entt", version: "3.13.2"},
+		{name: "imgui", version: "1.90.9"},
+		{name: "vulkan-headers", version: "1.3.290"},
+		{name: "catch2", version: "3.6.0", dev: true},
+	},
+}
+
+// runtimeDeps 返回语言的非开发依赖
+func runtimeDeps(language string) []dependency {
+	var result []dependency
+	for _, d := range thirdPartyDeps[language] {
+		if !d.dev {
+			result = append(result, d)
+		}
+	}
+	return result
+}
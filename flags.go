@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// devTypeNames 命令行中的开发类型名称，下标与 DevelopmentType 常量一致
+var devTypeNames = []string{
+	"backend", "frontend", "fullstack", "data-science", "devops",
+	"blockchain", "machine-learning", "systems-programming", "game-development", "security",
+}
+
+// jargonNames 命令行中的术语级别名称，下标与 JargonLevel 常量一致
+var jargonNames = []string{"low", "medium", "high", "extreme"}
+
+// enumFlag 取值为固定名称之一的命令行参数，名称的下标即枚举值
+type enumFlag[T ~int] struct {
+	names []string
+	value *T
+}
+
+// String 返回当前取值的名称
+func (f enumFlag[T]) String() string {
+	if f.value == nil {
+		return ""
+	}
+	return f.names[*f.value]
+}
+
+// Set 按名称设置取值，名称不区分大小写
+func (f enumFlag[T]) Set(s string) error {
+	i := slices.Index(f.names, strings.ToLower(s))
+	if i < 0 {
+		return fmt.Errorf("must be one of %s", strings.Join(f.names, ", "))
+	}
+	*f.value = T(i)
+	return nil
+}
+
+// choiceFlag 取值为固定字符串之一的命令行参数
+type choiceFlag struct {
+	choices []string
+	value   *string
+}
+
+// String 返回当前取值
+func (f choiceFlag) String() string {
+	if f.value == nil {
+		return ""
+	}
+	return *f.value
+}
+
+// Set 校验并设置取值
+func (f choiceFlag) Set(s string) error {
+	if !slices.Contains(f.choices, s) {
+		return fmt.Errorf("must be one of %s", strings.Join(f.choices, ", "))
+	}
+	*f.value = s
+	return nil
+}
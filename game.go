@@ -0,0 +1,319 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// 目标平台及其着色器格式
+var gamePlatforms = []struct {
+	name         string
+	shaderFormat string
+}{
+	{"Windows", "PCD3D_SM6"},
+	{"Linux", "SF_VULKAN_SM6"},
+}
+
+// 资源命名用的名词
+var assetNouns = []string{"Rock", "Crate", "Barrel", "Pine", "Door", "Hero", "Rifle", "Lantern", "Bridge", "Banner", "Drone", "Terrain"}
+
+// 资源所在的内容目录
+var assetFolders = []string{"Environment", "Characters", "Props", "Weapons", "VFX"}
+
+// 纹理用途及对应的压缩格式和每像素字节数
+var textureKinds = []struct {
+	suffix, format string
+	bytesPerPixel  float64
+}{
+	{"D", "BC7", 1},
+	{"N", "BC5", 1},
+	{"ORM", "BC1", 0.5},
+	{"E", "BC1", 0.5},
+}
+
+// cookedAsset 一个烘焙完成的资源
+type cookedAsset struct {
+	path        string
+	class       string
+	detail      string
+	source      float64 // 原始大小，单位 MB
+	cooked      float64 // 烘焙后大小，单位 MB
+	compression string
+}
+
+// frameStats 一帧的耗时统计，单位毫秒
+type frameStats struct {
+	game, render, gpu float64
+	drawCalls         int
+	prims             float64 // 百万个图元
+}
+
+// frameTime 返回一帧的总耗时，取最慢的线程再加上同步开销
+func (f frameStats) frameTime() float64 {
+	return max(f.game, f.render, f.gpu) + 0.3
+}
+
+// hitch 一次卡顿的成因及修复方式
+type hitch struct {
+	thread string
+	stat   string
+	fix    string
+}
+
+// assetPath 生成一个内容目录下的资源路径
+func assetPath(prefix, noun, suffix string) string {
+	folder := assetFolders[rand.Intn(len(assetFolders))]
+	name := prefix + "_" + noun
+	if suffix != "" {
+		name += "_" + suffix
+	}
+	return fmt.Sprintf("/Game/%s/%s/%s", folder, noun, name)
+}
+
+// cookTexture 按尺寸和压缩格式计算纹理大小，包含完整的 mip 链
+func cookTexture(noun string) cookedAsset {
+	kind := textureKinds[rand.Intn(len(textureKinds))]
+	size := 512 << rand.Intn(4)
+	pixels := float64(size * size)
+	return cookedAsset{
+		path:        assetPath("T", noun, kind.suffix),
+		class:       "Texture2D",
+		detail:      fmt.Sprintf("%dx%d %s", size, size, kind.format),
+		source:      pixels * 4 / (1 << 20),
+		cooked:      pixels * kind.bytesPerPixel * 4 / 3 / (1 << 20),
+		compression: kind.format,
+	}
+}
+
+// cookMesh 按三角形数和 LOD 计算网格大小
+func cookMesh(noun string) cookedAsset {
+	tris := rand.Intn(180000) + 4000
+	lods := rand.Intn(3) + 2
+	bytes := 0.0
+	for lod, t := 0, float64(tris); lod < lods; lod, t = lod+1, t/2 {
+		// 每个顶点 32 字节，每个三角形 3 个 32 位索引
+		bytes += t*0.6*32 + t*12
+	}
+	ratio := 0.6 + rand.Float64()*0.2
+	return cookedAsset{
+		path:        assetPath("SM", noun, ""),
+		class:       "StaticMesh",
+		detail:      fmt.Sprintf("%s tris, %d LODs", formatInt(tris), lods),
+		source:      bytes / (1 << 20),
+		cooked:      bytes * ratio / (1 << 20),
+		compression: "Oodle Kraken",
+	}
+}
+
+// cookAudio 按时长计算 48kHz 立体声音频的大小
+func cookAudio(noun string) cookedAsset {
+	seconds := rand.Float64()*90 + 0.5
+	raw := seconds * 48000 * 2 * 2
+	if rand.Intn(2) == 0 {
+		return cookedAsset{
+			path:        assetPath("SW", noun, ""),
+			class:       "SoundWave",
+			detail:      fmt.Sprintf("%.1fs 48kHz stereo", seconds),
+			source:      raw / (1 << 20),
+			cooked:      seconds * 128000 / 8 / (1 << 20),
+			compression: "Ogg Vorbis q0.4",
+		}
+	}
+	return cookedAsset{
+		path:        assetPath("SW", noun, ""),
+		class:       "SoundWave",
+		detail:      fmt.Sprintf("%.1fs 48kHz stereo", seconds),
+		source:      raw / (1 << 20),
+		cooked:      raw / 3.6 / (1 << 20),
+		compression: "ADPCM",
+	}
+}
+
+// cookAssets 生成一批待烘焙的资源
+func cookAssets() []cookedAsset {
+	var assets []cookedAsset
+	n := rand.Intn(6) + 8
+	for i := 0; i < n; i++ {
+		noun := assetNouns[rand.Intn(len(assetNouns))]
+		switch rand.Intn(5) {
+		case 0, 1:
+			assets = append(assets, cookTexture(noun))
+		case 2, 3:
+			assets = append(assets, cookMesh(noun))
+		default:
+			assets = append(assets, cookAudio(noun))
+		}
+	}
+	return assets
+}
+
+// formatMB 格式化以 MB 为单位的大小
+func formatMB(mb float64) string {
+	if mb < 1 {
+		return fmt.Sprintf("%.0f KB", mb*1024)
+	}
+	return fmt.Sprintf("%.1f MB", mb)
+}
+
+// repoClasses 返回虚拟仓库中指定子系统的类名
+func repoClasses(repo *FakeRepo, system string) []string {
+	var classes []string
+	for _, f := range repo.sourceFiles() {
+		if path.Base(f.dir) == system && strings.HasSuffix(f.path, ".cpp") {
+			classes = append(classes, strings.TrimSuffix(path.Base(f.path), ".cpp"))
+		}
+	}
+	if len(classes) == 0 {
+		classes = []string{system + "Manager"}
+	}
+	return classes
+}
+
+// gameHitches 生成可能的卡顿成因，类名取自虚拟仓库
+func gameHitches(repo *FakeRepo, material string) []hitch {
+	gameplay := repoClasses(repo, "Gameplay")
+	physics := repoClasses(repo, "Physics")
+	g := gameplay[rand.Intn(len(gameplay))]
+	p := physics[rand.Intn(len(physics))]
+	return []hitch{
+		{"Game", "U" + g + "::Tick", "Time-sliced U" + g + "::Tick with a 2 ms per-frame budget"},
+		{"Game", "F" + p + "::Update", "Moved F" + p + " rebuild to an async task graph job"},
+		{"Game", "CollectGarbage", "Enabled incremental garbage collection (gc.AllowIncrementalReachability=1)"},
+		{"Draw", "PSO compile " + material, "Added " + material + " to the PSO precache list"},
+		{"GPU", "ShadowDepths", "Capped virtual shadow map page invalidations per frame"},
+	}
+}
+
+// randomFrame 生成一帧正常的统计数据
+func randomFrame(base frameStats) frameStats {
+	jitter := func(v float64) float64 { return v * (0.92 + rand.Float64()*0.16) }
+	return frameStats{
+		game:      jitter(base.game),
+		render:    jitter(base.render),
+		gpu:       jitter(base.gpu),
+		drawCalls: base.drawCalls + rand.Intn(200) - 100,
+		prims:     jitter(base.prims),
+	}
+}
+
+// printFrame 按 stat unit 的风格打印一帧
+func printFrame(n int, f frameStats) {
+	line := fmt.Sprintf("  %5d %7.2f %7.2f %7.2f %7.2f %9s %7.2fM", n, f.frameTime(), f.game, f.render, f.gpu, formatInt(f.drawCalls), f.prims)
+	if f.frameTime() > 33.3 {
+		line = red(line)
+	} else if f.frameTime() > 16.7 {
+		line = yellow(line)
+	}
+	fmt.Println(line)
+	time.Sleep(time.Duration(rand.Intn(90)+60) * time.Millisecond)
+}
+
+// runGameBuild 模拟游戏引擎的资源烘焙、着色器编译和逐帧性能分析
+func runGameBuild(config *SessionConfig) {
+	module := pascalCase(config.naming.name)
+	platform := gamePlatforms[rand.Intn(len(gamePlatforms))]
+	fmt.Println(blue("🎮 " + tr("game.title", module, platform.name)))
+
+	// 资源烘焙
+	fmt.Printf("$ UnrealEditor-Cmd %s.uproject -run=cook -targetplatform=%s -iterate\n", module, platform.name)
+	assets := cookAssets()
+	var source, cooked float64
+	for _, a := range assets {
+		fmt.Printf("LogCook: Display: Cooking %-40s %-10s %-22s %9s -> %9s (%s)\n",
+			a.path, a.class, a.detail, formatMB(a.source), formatMB(a.cooked), a.compression)
+		source += a.source
+		cooked += a.cooked
+		time.Sleep(time.Duration(rand.Intn(150)+60) * time.Millisecond)
+	}
+	fmt.Printf("📦 %s\n", tr("game.cooked", len(assets), formatFloat(source, 1), formatFloat(cooked, 1)))
+
+	// 着色器排列：静态开关 × 顶点工厂 × 渲染通道 × 质量等级
+	materials := rand.Intn(30) + 20
+	type materialShaders struct {
+		name         string
+		permutations int
+	}
+	var shaders []materialShaders
+	total := 0
+	for i := 0; i < materials; i++ {
+		switches := rand.Intn(5) + 1
+		factories := rand.Intn(3) + 1
+		perms := (1 << switches) * factories * 4 * 3
+		name := "M_" + assetNouns[rand.Intn(len(assetNouns))] + fmt.Sprintf("_%02d", i)
+		shaders = append(shaders, materialShaders{name, perms})
+		total += perms
+	}
+	sort.Slice(shaders, func(i, j int) bool { return shaders[i].permutations > shaders[j].permutations })
+	cached := total * (rand.Intn(25) + 60) / 100
+	fmt.Printf("LogShaderCompilers: Display: %d materials, %d shader permutations for %s (%d found in DDC)\n",
+		materials, total, platform.shaderFormat, cached)
+	for _, s := range shaders[:3] {
+		fmt.Printf("LogShaderCompilers: Display:   %-16s %5d permutations\n", s.name, s.permutations)
+	}
+	remaining := total - cached
+	workers := rand.Intn(16) + 16
+	for remaining > 0 {
+		fmt.Printf("LogShaderCompilers: Display: Shaders left to compile %d (%d workers)\n", remaining, workers)
+		remaining -= rand.Intn(total/4+1) + total/8 + 1
+		time.Sleep(time.Duration(rand.Intn(300)+150) * time.Millisecond)
+	}
+	fmt.Printf("LogShaderCompilers: Display: Compiled %d shaders in %.2fs\n", total-cached, float64(total-cached)/float64(workers)*0.012+rand.Float64())
+	fmt.Printf("🎨 %s\n", tr("game.shaders", formatInt(total), formatInt(cached)))
+
+	// 逐帧性能分析，中途出现卡顿并修复
+	base := frameStats{
+		game:      rand.Float64()*4 + 7,
+		render:    rand.Float64()*4 + 6,
+		gpu:       rand.Float64()*4 + 10,
+		drawCalls: rand.Intn(2000) + 1500,
+		prims:     rand.Float64()*3 + 1,
+	}
+	cause := gameHitches(config.repo, shaders[0].name)
+	h := cause[rand.Intn(len(cause))]
+	fmt.Printf("\n> stat unit\n  %5s %7s %7s %7s %7s %9s %8s\n", "Frame", "Frame", "Game", "Draw", "GPU", "Draws", "Prims")
+	frames := rand.Intn(4) + 6
+	spike := rand.Intn(frames-2) + 2
+	start := rand.Intn(5000) + 1000
+	var spikeStats frameStats
+	for i := 0; i < frames; i++ {
+		f := randomFrame(base)
+		if i == spike {
+			extra := rand.Float64()*30 + 18
+			switch h.thread {
+			case "Game":
+				f.game += extra
+			case "Draw":
+				f.render += extra
+			default:
+				f.gpu += extra
+			}
+			spikeStats = f
+		}
+		printFrame(start+i, f)
+	}
+	fmt.Printf("⚠️  %s\n", tr("game.hitch", start+spike, formatFloat(spikeStats.frameTime(), 2), h.thread, h.stat))
+	time.Sleep(time.Duration(rand.Intn(500)+400) * time.Millisecond)
+	fmt.Printf("🔧 %s\n", tr("game.fixed", h.fix))
+
+	// 修复后再采样，卡顿消失，整体也略有改善
+	base.game *= 0.95
+	base.gpu *= 0.97
+	sum, worst := 0.0, 0.0
+	samples := rand.Intn(3) + 4
+	start += frames + rand.Intn(400) + 100
+	for i := 0; i < samples; i++ {
+		f := randomFrame(base)
+		printFrame(start+i, f)
+		sum += f.frameTime()
+		worst = max(worst, f.frameTime())
+	}
+	avg := sum / float64(samples)
+	fmt.Printf("✅ %s\n", tr("game.summary", formatFloat(avg, 2), formatFloat(1000/avg, 1), formatFloat(worst, 2)))
+	if rand.Float32() < jargonDensity(config.jargonLevel) {
+		fmt.Printf("🧠 %s\n", tr("jargon.insight", generatePerformanceJargon(config.devType, config.jargonLevel)))
+	}
+}
@@ -0,0 +1,290 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"path"
+	"strings"
+	"time"
+)
+
+// gitCommit 会话中产生的一次提交
+type gitCommit struct {
+	sha     string
+	subject string
+}
+
+// pullRequest 推送分支后打开的合并请求
+type pullRequest struct {
+	number int
+	branch string
+	title  string
+}
+
+// GitState 会话内的 git 状态，供 git 活动和团队动态共享
+type GitState struct {
+	mainSHA  string
+	branch   string // 当前工作分支，为空表示还在 main 上
+	commits  []gitCommit
+	pushed   bool   // 当前分支是否已推送
+	remote   string // 远端分支上的提交
+	openPRs  []pullRequest
+	nextPR   int
+	upstream string // git@github.com:org/name.git
+}
+
+// newGitState 创建会话的初始 git 状态
+func newGitState(naming *ProjectNaming) *GitState {
+	return &GitState{
+		mainSHA:  randomHex(7),
+		nextPR:   rand.Intn(900) + 100,
+		upstream: "git@" + strings.Replace(naming.modulePath, "/", ":", 1) + ".git",
+	}
+}
+
+// prFor 返回分支对应的合并请求
+func (g *GitState) prFor(branch string) *pullRequest {
+	for i := range g.openPRs {
+		if g.openPRs[i].branch == branch {
+			return &g.openPRs[i]
+		}
+	}
+	return nil
+}
+
+// mergeRandomPR 合并一个已打开的合并请求，没有可合并的时返回 nil
+func (g *GitState) mergeRandomPR() *pullRequest {
+	if len(g.openPRs) == 0 {
+		return nil
+	}
+	i := rand.Intn(len(g.openPRs))
+	pr := g.openPRs[i]
+	g.openPRs = append(g.openPRs[:i], g.openPRs[i+1:]...)
+	g.mainSHA = randomHex(7)
+	if pr.branch == g.branch {
+		// 当前分支已合并，下次从 main 开新分支
+		g.branch, g.commits, g.pushed, g.remote = "", nil, false, ""
+	}
+	return &pr
+}
+
+// 常见的提交类型，按出现频率重复
+var commitTypes = []string{"feat", "feat", "fix", "fix", "perf", "refactor", "chore", "test"}
+
+// 生成分支名时忽略的词
+var branchStopWords = map[string]bool{
+	"a": true, "an": true, "the": true, "for": true, "with": true, "via": true, "to": true,
+	"of": true, "and": true, "in": true, "on": true, "from": true, "when": true, "into": true,
+}
+
+// commitSubject 由术语生成器生成一条约定式提交信息
+func commitSubject(config *SessionConfig, kind, scope string) string {
+	subject := fmt.Sprintf("%s(%s): %s", kind, scope, lowerFirst(generateCodeJargon(config.devType, config.jargonLevel)))
+	if len(subject) <= 72 {
+		return subject
+	}
+	// 按单词截断到 72 列以内
+	cut := strings.LastIndex(subject[:72], " ")
+	return strings.TrimRight(subject[:cut], ",;:")
+}
+
+// branchName 根据提交信息生成分支名，如 perf/billing-optimized-query-execution
+func branchName(kind, scope, subject string) string {
+	desc := subject[strings.Index(subject, ": ")+2:]
+	var words []string
+	for _, w := range strings.Fields(strings.ToLower(desc)) {
+		w = strings.Trim(w, ",.;:()'\"")
+		if w == "" || branchStopWords[w] || strings.ContainsAny(w, "/&+") {
+			continue
+		}
+		words = append(words, w)
+		if len(words) == 3 {
+			break
+		}
+	}
+	return fmt.Sprintf("%s/%s-%s", kind, scope, strings.Join(words, "-"))
+}
+
+// diffStatLine 一行 --stat 输出
+type diffStatLine struct {
+	path       string
+	insertions int
+	deletions  int
+}
+
+// printDiffStat 按 git --stat 的格式打印改动统计
+func printDiffStat(stats []diffStatLine) {
+	insertions, deletions := 0, 0
+	width, maxChanges := 0, 0
+	for _, s := range stats {
+		width = max(width, len(s.path))
+		maxChanges = max(maxChanges, s.insertions+s.deletions)
+	}
+	scale := 1.0
+	if maxChanges > 40 {
+		scale = 40.0 / float64(maxChanges)
+	}
+	for _, s := range stats {
+		plus := int(float64(s.insertions)*scale + 0.5)
+		minus := int(float64(s.deletions)*scale + 0.5)
+		fmt.Printf(" %-*s | %3d %s%s\n", width, s.path, s.insertions+s.deletions,
+			green(strings.Repeat("+", plus)), red(strings.Repeat("-", minus)))
+		insertions += s.insertions
+		deletions += s.deletions
+	}
+	fmt.Println(diffSummary(len(stats), insertions, deletions))
+}
+
+// diffSummary 生成 git 的改动汇总行，注意单复数
+func diffSummary(files, insertions, deletions int) string {
+	plural := func(n int, one, other string) string {
+		if n == 1 {
+			return fmt.Sprintf("%d %s", n, one)
+		}
+		return fmt.Sprintf("%d %s", n, other)
+	}
+	return fmt.Sprintf(" %s, %s, %s", plural(files, "file changed", "files changed"),
+		plural(insertions, "insertion(+)", "insertions(+)"), plural(deletions, "deletion(-)", "deletions(-)"))
+}
+
+// randomDiffStat 在同一个目录中挑选几个文件生成改动统计
+func randomDiffStat(repo *FakeRepo) []diffStatLine {
+	anchor := repo.randomFile()
+	files := repo.filesIn(anchor.dir)
+	rand.Shuffle(len(files), func(i, j int) { files[i], files[j] = files[j], files[i] })
+	files = files[:min(len(files), rand.Intn(3)+1)]
+	if len(files) < 3 && rand.Float32() < 0.5 {
+		files = append(files, repo.randomFile())
+	}
+
+	var stats []diffStatLine
+	seen := map[string]bool{}
+	for _, f := range files {
+		if seen[f.path] {
+			continue
+		}
+		seen[f.path] = true
+		stats = append(stats, diffStatLine{path: f.path, insertions: rand.Intn(60) + 1, deletions: rand.Intn(25)})
+	}
+	return stats
+}
+
+// scopeFor 根据改动的文件推导提交作用域
+func scopeFor(stats []diffStatLine) string {
+	return strings.ToLower(path.Base(path.Dir(stats[0].path)))
+}
+
+// gitStep 打印一条命令并稍作停顿
+func gitStep(command string) {
+	fmt.Println(blue("$ " + command))
+	time.Sleep(time.Duration(rand.Intn(250)+100) * time.Millisecond)
+}
+
+// runGitWorkflow 模拟一次拉取、提交、变基、解决冲突和推送的 git 工作流
+func runGitWorkflow(config *SessionConfig) {
+	g := config.git
+	repo := config.repo
+
+	// 先准备本次提交，以便标题中能显示分支名
+	stats := randomDiffStat(repo)
+	scope := scopeFor(stats)
+	kind := commitTypes[rand.Intn(len(commitTypes))]
+	subject := commitSubject(config, kind, scope)
+	newBranch := g.branch == ""
+	if newBranch {
+		g.branch = branchName(kind, scope, subject)
+	}
+	fmt.Println(green("🌿 " + tr("git.title", g.branch, "origin/main")))
+
+	// 拉取远端更新
+	gitStep("git fetch origin")
+	objects := rand.Intn(80) + 10
+	fmt.Printf("remote: Enumerating objects: %d, done.\n", objects)
+	fmt.Printf("remote: Counting objects: 100%% (%d/%d), done.\n", objects, objects)
+	fmt.Printf("remote: Total %d (delta %d), reused %d (delta %d), pack-reused 0\n",
+		objects*2/3, objects/3, objects/2, objects/4)
+	fmt.Printf("From %s\n", strings.TrimSuffix(strings.TrimPrefix(g.upstream, "git@"), ".git"))
+	oldMain := g.mainSHA
+	g.mainSHA = randomHex(7)
+	fmt.Printf("   %s..%s  main       -> origin/main\n", oldMain, g.mainSHA)
+
+	if newBranch {
+		gitStep("git switch -c " + g.branch + " origin/main")
+		fmt.Printf("Switched to a new branch '%s'\n", g.branch)
+	}
+
+	// 提交
+	gitStep(fmt.Sprintf("git commit -am %q", subject))
+	sha := randomHex(7)
+	fmt.Printf("[%s %s] %s\n", g.branch, sha, subject)
+	insertions, deletions := 0, 0
+	for _, s := range stats {
+		insertions += s.insertions
+		deletions += s.deletions
+	}
+	fmt.Println(diffSummary(len(stats), insertions, deletions))
+	g.commits = append(g.commits, gitCommit{sha: sha, subject: subject})
+
+	// 变基到最新的 main，偶尔需要解决冲突；刚从 origin/main 切出的分支无需变基
+	gitStep("git rebase origin/main")
+	if newBranch {
+		fmt.Printf("Current branch %s is up to date.\n", g.branch)
+	} else {
+		rebased := randomHex(7)
+		if rand.Float32() < 0.4 {
+			conflict := stats[0].path
+			fmt.Printf("Auto-merging %s\n", conflict)
+			fmt.Println(red(fmt.Sprintf("CONFLICT (content): Merge conflict in %s", conflict)))
+			fmt.Printf("error: could not apply %s... %s\n", sha, subject)
+			fmt.Println(yellow("hint: Resolve all conflicts manually, mark them as resolved with"))
+			fmt.Println(yellow("hint: \"git add/rm <conflicted_files>\", then run \"git rebase --continue\"."))
+			fmt.Printf("✏️  %s\n", tr("git.resolving", conflict))
+			time.Sleep(time.Duration(rand.Intn(600)+300) * time.Millisecond)
+			gitStep("git add " + conflict)
+			gitStep("git rebase --continue")
+			fmt.Printf("[detached HEAD %s] %s\n", rebased, subject)
+		}
+		// 变基会重写本分支的所有提交
+		for i := range g.commits {
+			g.commits[i].sha = randomHex(7)
+		}
+		sha = rebased
+		g.commits[len(g.commits)-1].sha = sha
+		fmt.Printf("Successfully rebased and updated refs/heads/%s.\n", g.branch)
+	}
+
+	gitStep("git show --stat --oneline HEAD")
+	fmt.Printf("%s %s\n", yellow(sha), subject)
+	printDiffStat(stats)
+
+	// 推送，已推送过的分支变基后需要强制推送
+	if g.pushed {
+		gitStep("git push --force-with-lease")
+	} else {
+		gitStep("git push -u origin " + g.branch)
+	}
+	written := rand.Intn(20) + 5
+	fmt.Printf("Enumerating objects: %d, done.\n", written)
+	fmt.Printf("Writing objects: 100%% (%d/%d), %.2f KiB | %.2f MiB/s, done.\n",
+		written/2+1, written/2+1, rand.Float64()*8+1, rand.Float64()*3+0.5)
+	fmt.Printf("To %s\n", g.upstream)
+	if g.pushed {
+		fmt.Printf(" + %s...%s %s -> %s (forced update)\n", g.remote, sha, g.branch, g.branch)
+	} else {
+		fmt.Printf(" * [new branch]      %s -> %s\n", g.branch, g.branch)
+		fmt.Printf("branch '%s' set up to track 'origin/%s'.\n", g.branch, g.branch)
+		g.pushed = true
+	}
+	g.remote = sha
+
+	pr := g.prFor(g.branch)
+	if pr == nil {
+		g.openPRs = append(g.openPRs, pullRequest{number: g.nextPR, branch: g.branch, title: g.commits[0].subject})
+		g.nextPR++
+		pr = &g.openPRs[len(g.openPRs)-1]
+		fmt.Printf("remote: View pull request for %s:\n", g.branch)
+		fmt.Printf("remote:   https://%s/pull/%d\n", config.naming.modulePath, pr.number)
+	}
+
+	fmt.Printf("📤 %s\n", tr("git.summary", trn("git.commits", len(g.commits)), g.branch, pr.number))
+}
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// goFrame goroutine 调用栈中的一帧
+type goFrame struct {
+	symbol string
+	file   string
+	line   int
+	offset int
+}
+
+// goroutineGroup goroutine?debug=1 输出中调用栈相同的一组 goroutine
+type goroutineGroup struct {
+	count  int
+	frames []goFrame
+}
+
+// 服务中常驻的标准库 goroutine
+var idleGoroutines = []struct {
+	min, max int
+	frames   []goFrame
+}{
+	{20, 90, []goFrame{
+		{"internal/poll.(*FD).Read", "/usr/local/go/src/internal/poll/fd_unix.go", 165, 0x27a},
+		{"net.(*conn).Read", "/usr/local/go/src/net/net.go", 189, 0x45},
+		{"net/http.(*connReader).backgroundRead", "/usr/local/go/src/net/http/server.go", 690, 0x37},
+	}},
+	{8, 40, []goFrame{
+		{"net/http.(*persistConn).readLoop", "/usr/local/go/src/net/http/transport.go", 2325, 0xca4},
+	}},
+	{8, 40, []goFrame{
+		{"net/http.(*persistConn).writeLoop", "/usr/local/go/src/net/http/transport.go", 2519, 0xe5},
+	}},
+	{1, 1, []goFrame{
+		{"internal/poll.(*FD).Accept", "/usr/local/go/src/internal/poll/fd_unix.go", 620, 0x295},
+		{"net.(*TCPListener).Accept", "/usr/local/go/src/net/tcpsock.go", 372, 0x30},
+		{"net/http.(*Server).Serve", "/usr/local/go/src/net/http/server.go", 3330, 0x30c},
+	}},
+	{1, 1, []goFrame{
+		{"database/sql.(*DB).connectionOpener", "/usr/local/go/src/database/sql/sql.go", 1253, 0x8d},
+	}},
+}
+
+// randomPC 生成一个落在程序文本段内的程序计数器
+func randomPC() int {
+	return 0x401000 + rand.Intn(0xa00000)
+}
+
+// printGoroutineGroup 按 debug=1 的格式打印一组 goroutine
+func printGoroutineGroup(g goroutineGroup) {
+	pcs := make([]string, 0, len(g.frames)+2)
+	pcs = append(pcs, fmt.Sprintf("0x%x", 0x43e000+rand.Intn(0x800)))
+	for range g.frames {
+		pcs = append(pcs, fmt.Sprintf("0x%x", randomPC()))
+	}
+	pcs = append(pcs, fmt.Sprintf("0x%x", 0x474000+rand.Intn(0x800)))
+	fmt.Printf("%d @ %s\n", g.count, strings.Join(pcs, " "))
+	for _, f := range g.frames {
+		fmt.Printf("#\t0x%x\t%s+0x%x\t%s:%d\n", randomPC(), f.symbol, f.offset, f.file, f.line)
+	}
+	fmt.Println()
+}
+
+// heapType viewcore histogram 中的一行
+type heapType struct {
+	name  string
+	count int
+	size  int
+}
+
+// gcCycle gctrace 中的一次 GC
+type gcCycle struct {
+	n                 int
+	at                float64 // 进程启动后的秒数
+	start, end, live  int     // MB
+	goal              int
+	wall, concurrent  float64 // ms
+	assist, bg, idle  float64 // ms cpu
+	percent, maxProcs int
+}
+
+// format 按 GODEBUG=gctrace=1 的格式输出
+func (c gcCycle) format() string {
+	return fmt.Sprintf("gc %d @%.3fs %d%%: %.3f+%.1f+%.3f ms clock, %.2f+%.2f/%.1f/%.1f+%.3f ms cpu, %d->%d->%d MB, %d MB goal, 0 MB stacks, 0 MB globals, %d P",
+		c.n, c.at, c.percent, c.wall, c.concurrent, c.wall/3,
+		c.wall*float64(c.maxProcs), c.assist, c.bg, c.idle, c.wall*float64(c.maxProcs)/3,
+		c.start, c.end, c.live, c.goal, c.maxProcs)
+}
+
+// runGoroutineDump 模拟排查 Go 服务的内存泄漏：goroutine 转储、gctrace、堆按类型统计，最后定位到静态检查发现的问题
+func runGoroutineDump(config *SessionConfig) {
+	service := config.naming.randomService()
+	deployment := config.naming.serviceName(service)
+	ns := config.naming.namespace
+	frames := stackFrames(config.repo, config.naming, service)
+	leakFile, creatorFile := frames[0], frames[0]
+	if len(frames) > 1 && frames[1].dir == frames[0].dir {
+		creatorFile = frames[1]
+	}
+	leakSymbol := repoSymbol(leakFile, "watch", config.naming)
+	creatorSymbol := repoSymbol(creatorFile, "Subscribe", config.naming)
+	leakLine := rand.Intn(max(leakFile.lines-20, 1)) + 10
+	creatorLine := rand.Intn(max(creatorFile.lines-20, 1)) + 10
+	leaked := rand.Intn(12000) + 3000
+	minutes := rand.Intn(80) + 15
+
+	fmt.Println(blue("🩺 " + tr("rt.title", deployment)))
+	fmt.Println(blue(fmt.Sprintf("$ kubectl port-forward -n %s deploy/%s 6060:6060 &", ns, deployment)))
+
+	// goroutine 概览：泄漏的那组数量远超其余
+	groups := []goroutineGroup{{count: leaked, frames: []goFrame{
+		{"runtime.selectgo", "/usr/local/go/src/runtime/select.go", 335, 0x7d4},
+		{leakSymbol, "/app/" + leakFile.path, leakLine, rand.Intn(0x300) + 0x40},
+	}}}
+	total := leaked
+	for _, g := range idleGoroutines {
+		n := rand.Intn(g.max-g.min+1) + g.min
+		groups = append(groups, goroutineGroup{count: n, frames: g.frames})
+		total += n
+	}
+	fmt.Println(blue("$ curl -s 'localhost:6060/debug/pprof/goroutine?debug=1' | head -n 24"))
+	fmt.Printf("goroutine profile: total %d\n", total)
+	sort.SliceStable(groups, func(i, j int) bool { return groups[i].count > groups[j].count })
+	for _, g := range groups[:4] {
+		printGoroutineGroup(g)
+		time.Sleep(time.Duration(rand.Intn(200)+100) * time.Millisecond)
+	}
+
+	// 其中一个泄漏的 goroutine 的完整调用栈
+	fmt.Println(blue(fmt.Sprintf("$ curl -s 'localhost:6060/debug/pprof/goroutine?debug=2' | grep -F -m1 -B1 -A3 '%s('", path.Base(leakSymbol))))
+	fmt.Println(yellow(fmt.Sprintf("goroutine %d [select, %d minutes]:", rand.Intn(900000)+1000, minutes)))
+	fmt.Printf("%s(0xc%09x, {0x%x, 0xc%09x})\n", leakSymbol, rand.Int63n(1<<36), randomPC(), rand.Int63n(1<<36))
+	fmt.Printf("\t/app/%s:%d +0x%x\n", leakFile.path, leakLine, rand.Intn(0x300)+0x40)
+	fmt.Printf("created by %s in goroutine %d\n", creatorSymbol, rand.Intn(9000)+100)
+	fmt.Printf("\t/app/%s:%d +0x%x\n", creatorFile.path, creatorLine, rand.Intn(0x300)+0x40)
+	time.Sleep(time.Duration(rand.Intn(300)+200) * time.Millisecond)
+
+	// 堆按类型统计：每个泄漏的 goroutine 持有一个 timerCtx、一个 channel、一个订阅和它的读缓冲
+	pkg := config.naming.modulePath + "/" + leakFile.dir
+	types := []heapType{
+		{"context.timerCtx", leaked + rand.Intn(200), 96},
+		{"runtime.hchan", leaked + rand.Intn(400), 96},
+		{"runtime.g", total + rand.Intn(50), 448},
+		{pkg + ".subscription", leaked, 64},
+		{"runtime.sudog", leaked + rand.Intn(100), 96},
+		{"[]uint8", leaked + rand.Intn(3000), 4096},
+		{"map.bucket[string]interface {}", rand.Intn(6000) + 2000, 272},
+		{"net/http.Header", rand.Intn(4000) + 500, 48},
+		{"[]string", rand.Intn(20000) + 4000, 32},
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].count*types[i].size > types[j].count*types[j].size })
+	fmt.Println(blue(fmt.Sprintf("$ viewcore /tmp/core.1 --exe bin/%s histogram | head -n 9", service)))
+	fmt.Printf("%9s %6s %11s %s\n", "count", "size", "bytes", "type")
+	histogram := 0
+	for _, t := range types[:8] {
+		line := fmt.Sprintf("%9d %6d %11d %s", t.count, t.size, t.count*t.size, t.name)
+		if t.count >= leaked {
+			line = red(line)
+		}
+		fmt.Println(line)
+		histogram += t.count * t.size
+	}
+
+	// gctrace：每轮 GC 之后的存活堆持续上涨
+	procs := []int{2, 4, 8}[rand.Intn(3)]
+	live := histogram>>20 + rand.Intn(60) + 40
+	first := live * (rand.Intn(10) + 75) / 100
+	cycles := rand.Intn(3) + 6
+	fmt.Println(blue(fmt.Sprintf("$ kubectl logs -n %s deploy/%s --since=%dm | grep '^gc ' | tail -n %d", ns, deployment, minutes, cycles)))
+	c := gcCycle{n: rand.Intn(3000) + 400, at: float64(rand.Intn(20000) + 3000), maxProcs: procs, percent: rand.Intn(3) + 1, live: first}
+	for i := 0; i < cycles; i++ {
+		prev := c.live
+		c.n++
+		c.at += float64(rand.Intn(60000)+30000) / 1000
+		c.goal = prev * 2
+		c.start = c.goal - rand.Intn(max(prev/20, 1))
+		c.end = c.start + rand.Intn(4) + 1
+		c.live = first + (live-first)*(i+1)/cycles
+		c.wall = float64(rand.Intn(120)+20) / 1000
+		c.concurrent = float64(rand.Intn(60)+10) / 10
+		c.assist = float64(rand.Intn(300)) / 100
+		c.bg = float64(rand.Intn(300)+50) / 10
+		c.idle = float64(rand.Intn(400)+20) / 10
+		fmt.Println(c.format())
+		time.Sleep(time.Duration(rand.Intn(150)+80) * time.Millisecond)
+	}
+	fmt.Printf("📈 %s\n", tr("rt.heap", formatInt(first), formatInt(live), trn("rt.cycles", cycles)))
+
+	// 结论：与静态检查的 lostcancel 问题对应
+	fmt.Println()
+	fmt.Println(red("🔍 " + tr("rt.leak", formatInt(leaked), leakSymbol)))
+	for _, rule := range rulesFor("go", config.devType) {
+		if rule.category != issueMemoryLeak {
+			continue
+		}
+		finding := LintFinding{path: creatorFile.path, language: "go", line: creatorLine, col: rand.Intn(8) + 2, rule: rule}
+		finding.message = fillIdentifier(rule.message, "cancel")
+		finding.ident = "cancel"
+		fmt.Printf("🔗 %s\n", tr("rt.linked", issueLabel(rule.category)))
+		fmt.Printf("    %s %s\n", severityLabel(rule.severity), finding.format())
+		fmt.Printf("            ↳ %s\n", tr("analysis.fix", finding.fix()))
+		break
+	}
+	if rand.Float32() < jargonDensity(config.jargonLevel) {
+		fmt.Printf("🧠 %s\n", tr("jargon.insight", generatePerformanceJargon(config.devType, config.jargonLevel)))
+	}
+}
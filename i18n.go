@@ -0,0 +1,180 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Locale 输出语言环境
+type Locale struct {
+	tag        string
+	decimalSep string
+	groupSep   string
+	percentFmt string             // 百分比格式，如 "%s%%" 或 "%s %%"
+	plural     func(n int) string // 返回 CLDR 复数类别 "one" / "other"
+	messages   map[string]string
+}
+
+// 支持的语言，消息目录见 catalog_*.go
+var locales = map[string]*Locale{
+	"en": {
+		tag:        "en",
+		decimalSep: ".",
+		groupSep:   ",",
+		percentFmt: "%s%%",
+		plural:     pluralOneOther,
+		messages:   catalogEN,
+	},
+	"zh-CN": {
+		tag:        "zh-CN",
+		decimalSep: ".",
+		groupSep:   ",",
+		percentFmt: "%s%%",
+		plural:     pluralOtherOnly,
+		messages:   catalogZhCN,
+	},
+	"ja": {
+		tag:        "ja",
+		decimalSep: ".",
+		groupSep:   ",",
+		percentFmt: "%s%%",
+		plural:     pluralOtherOnly,
+		messages:   catalogJA,
+	},
+	"de": {
+		tag:        "de",
+		decimalSep: ",",
+		groupSep:   ".",
+		percentFmt: "%s %%",
+		plural:     pluralOneOther,
+		messages:   catalogDE,
+	},
+}
+
+// 当前语言环境
+var locale = locales["en"]
+
+// 英语、德语：只有 1 使用单数
+func pluralOneOther(n int) string {
+	if n == 1 {
+		return "one"
+	}
+	return "other"
+}
+
+// 中文、日语：没有单复数之分
+func pluralOtherOnly(n int) string {
+	return "other"
+}
+
+// selectLocale 按 --lang、LC_ALL、LC_MESSAGES、LANG 的顺序选择语言
+func selectLocale(lang string) *Locale {
+	candidates := []string{lang, os.Getenv("LC_ALL"), os.Getenv("LC_MESSAGES"), os.Getenv("LANG")}
+	for _, c := range candidates {
+		if c == "" {
+			continue
+		}
+		if l, ok := locales[normalizeLocaleTag(c)]; ok {
+			return l
+		}
+		// 显式指定但不支持的语言直接回退到英语，不再继续查找环境变量
+		return locales["en"]
+	}
+	return locales["en"]
+}
+
+// normalizeLocaleTag 将 zh_CN.UTF-8、de_DE@euro 之类的值转换为目录使用的标签
+func normalizeLocaleTag(value string) string {
+	tag := value
+	if i := strings.IndexAny(tag, ".@"); i >= 0 {
+		tag = tag[:i]
+	}
+	tag = strings.ToLower(strings.ReplaceAll(tag, "_", "-"))
+	switch {
+	case strings.HasPrefix(tag, "zh"):
+		return "zh-CN"
+	case strings.HasPrefix(tag, "ja"):
+		return "ja"
+	case strings.HasPrefix(tag, "de"):
+		return "de"
+	case strings.HasPrefix(tag, "en"), tag == "c", tag == "posix":
+		return "en"
+	}
+	return tag
+}
+
+// lookup 查找消息，缺失时回退到英语，再缺失则返回键名
+func (l *Locale) lookup(key string) string {
+	if msg, ok := l.messages[key]; ok {
+		return msg
+	}
+	if msg, ok := catalogEN[key]; ok {
+		return msg
+	}
+	return key
+}
+
+// tr 翻译消息并按参数格式化
+func tr(key string, args ...any) string {
+	msg := locale.lookup(key)
+	if len(args) == 0 {
+		return msg
+	}
+	return fmt.Sprintf(msg, args...)
+}
+
+// trn 翻译带数量的消息，数量按当前语言格式化后作为第一个参数
+func trn(key string, n int, args ...any) string {
+	msg := locale.lookup(key + "#" + locale.plural(n))
+	if msg == key+"#"+locale.plural(n) {
+		msg = locale.lookup(key + "#other")
+	}
+	return fmt.Sprintf(msg, append([]any{formatInt(n)}, args...)...)
+}
+
+// formatInt 按当前语言格式化整数（千位分隔）
+func formatInt(n int) string {
+	s := strconv.Itoa(n)
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+
+	var b strings.Builder
+	for i, d := range s {
+		if i > 0 && (len(s)-i)%3 == 0 {
+			b.WriteString(locale.groupSep)
+		}
+		b.WriteRune(d)
+	}
+	if neg {
+		return "-" + b.String()
+	}
+	return b.String()
+}
+
+// formatFloat 按当前语言格式化小数
+func formatFloat(f float64, precision int) string {
+	s := strconv.FormatFloat(math.Abs(f), 'f', precision, 64)
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	n, _ := strconv.Atoi(intPart)
+	result := formatInt(n)
+	if fracPart != "" {
+		result += locale.decimalSep + fracPart
+	}
+	if f < 0 {
+		return "-" + result
+	}
+	return result
+}
+
+// formatPercent 按当前语言格式化百分比
+func formatPercent(n int) string {
+	return fmt.Sprintf(locale.percentFmt, formatInt(n))
+}
+
+// formatPercentFloat 按当前语言格式化带小数的百分比
+func formatPercentFloat(f float64, prec int) string {
+	return fmt.Sprintf(locale.percentFmt, formatFloat(f, prec))
+}
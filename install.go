@@ -0,0 +1,1184 @@
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// semver 语义化版本号
+type semver struct {
+	major, minor, patch int
+	pre                 string // 预发布标记，Go 伪版本的时间戳也放在这里
+}
+
+// parseSemver 解析 1.2.3、v1.2.3、18 这类版本号，缺失的部分视为 0，构建元数据被忽略
+func parseSemver(s string) semver {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "v")
+	s, _, _ = strings.Cut(s, "+")
+	var v semver
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		s, v.pre = s[:i], s[i+1:]
+	}
+	nums := []*int{&v.major, &v.minor, &v.patch}
+	for i, p := range strings.SplitN(s, ".", 3) {
+		*nums[i], _ = strconv.Atoi(p)
+	}
+	return v
+}
+
+// compare 比较两个版本号，返回值的符号表示大小关系；有预发布标记的版本较小
+func (a semver) compare(b semver) int {
+	if d := a.compareCore(b); d != 0 {
+		return d
+	}
+	switch {
+	case a.pre == b.pre:
+		return 0
+	case a.pre == "":
+		return 1
+	case b.pre == "":
+		return -1
+	}
+	return comparePrerelease(a.pre, b.pre)
+}
+
+// compareCore 只比较主版本、次版本和补丁版本
+func (a semver) compareCore(b semver) int {
+	for _, d := range []int{a.major - b.major, a.minor - b.minor, a.patch - b.patch} {
+		if d != 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// comparePrerelease 按语义化版本的规则逐段比较预发布标记：数字段按数值比较且小于非数字段，前缀相同时段数少的较小
+func comparePrerelease(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aErr := strconv.Atoi(as[i])
+		bn, bErr := strconv.Atoi(bs[i])
+		switch {
+		case aErr == nil && bErr == nil:
+			if an != bn {
+				return an - bn
+			}
+		case aErr == nil:
+			return -1
+		case bErr == nil:
+			return 1
+		default:
+			if d := strings.Compare(as[i], bs[i]); d != 0 {
+				return d
+			}
+		}
+	}
+	return len(as) - len(bs)
+}
+
+// compatible 按 ^ 的规则判断两个版本是否兼容：1.x 看主版本，0.x 看次版本，0.0.x 必须相同
+func (a semver) compatible(b semver) bool {
+	switch {
+	case b.major > 0:
+		return a.major == b.major
+	case b.minor > 0:
+		return a.major == 0 && a.minor == b.minor
+	}
+	return a.major == 0 && a.minor == 0 && a.patch == b.patch
+}
+
+// satisfies 判断版本是否满足约束，支持 ||、空格分隔的多个比较符以及 ^ ~ >= < = *。
+// 和 npm、Cargo 一样，预发布版本只有在同一组比较符中有同一版本号的预发布时才可能满足
+func satisfies(version, constraint string) bool {
+	v := parseSemver(version)
+	for _, alt := range strings.Split(constraint, "||") {
+		ok, preAllowed := true, v.pre == ""
+		for _, c := range strings.Fields(alt) {
+			if !satisfiesOne(v, c) {
+				ok = false
+				break
+			}
+			bound := parseSemver(strings.TrimLeft(c, "^~<>="))
+			if bound.pre != "" && bound.compareCore(v) == 0 {
+				preAllowed = true
+			}
+		}
+		if ok && preAllowed {
+			return true
+		}
+	}
+	return false
+}
+
+// satisfiesOne 判断版本是否满足单个比较符，不带运算符的版本号表示精确匹配
+func satisfiesOne(v semver, c string) bool {
+	switch {
+	case c == "*":
+		return true
+	case strings.HasPrefix(c, ">="):
+		return v.compare(parseSemver(c[2:])) >= 0
+	case strings.HasPrefix(c, "<"):
+		return v.compare(parseSemver(c[1:])) < 0
+	case strings.HasPrefix(c, "^"):
+		lo := parseSemver(c[1:])
+		return v.compare(lo) >= 0 && v.compatible(lo)
+	case strings.HasPrefix(c, "~"):
+		lo := parseSemver(c[1:])
+		return v.compare(lo) >= 0 && v.major == lo.major && v.minor == lo.minor
+	}
+	return v.compare(parseSemver(strings.TrimPrefix(c, "="))) == 0
+}
+
+// pkgReq 一条依赖声明
+type pkgReq struct {
+	name       string
+	constraint string // Go 中是最低版本
+	peer       bool   // npm 的 peerDependencies
+}
+
+// pkgRelease 注册表中某个包的一次发布
+type pkgRelease struct {
+	version string
+	deps    []pkgReq
+}
+
+// packageIndex 包注册表：包名到按版本从新到旧排列的发布
+type packageIndex map[string][]pkgRelease
+
+// newPackageIndex 解析 "name@version": "dep 约束, peer dep 约束" 形式的注册表数据
+func newPackageIndex(data map[string]string) packageIndex {
+	ix := packageIndex{}
+	for key, deps := range data {
+		at := strings.LastIndexByte(key, '@')
+		rel := pkgRelease{version: key[at+1:]}
+		for _, d := range strings.Split(deps, ",") {
+			d = strings.TrimSpace(d)
+			if d == "" {
+				continue
+			}
+			var req pkgReq
+			if rest, ok := strings.CutPrefix(d, "peer "); ok {
+				req.peer, d = true, rest
+			}
+			req.name, req.constraint, _ = strings.Cut(d, " ")
+			rel.deps = append(rel.deps, req)
+		}
+		ix[key[:at]] = append(ix[key[:at]], rel)
+	}
+	for _, rels := range ix {
+		sort.Slice(rels, func(i, j int) bool {
+			return parseSemver(rels[i].version).compare(parseSemver(rels[j].version)) > 0
+		})
+	}
+	return ix
+}
+
+// resolve 返回满足约束的最高版本
+func (ix packageIndex) resolve(name, constraint string) (pkgRelease, bool) {
+	for _, rel := range ix[name] {
+		if satisfies(rel.version, constraint) {
+			return rel, true
+		}
+	}
+	return pkgRelease{}, false
+}
+
+// release 返回指定版本的发布，注册表中没有记录的版本视为没有依赖
+func (ix packageIndex) release(name, version string) pkgRelease {
+	for _, rel := range ix[name] {
+		if rel.version == version {
+			return rel
+		}
+	}
+	return pkgRelease{version: version}
+}
+
+// pkgInstance 解析结果中的一个包实例：npm 的一个 node_modules 目录或 Cargo.lock 中的一个条目
+type pkgInstance struct {
+	name, version string
+	location      string // npm 中相对项目根目录的安装位置
+	deps          []*pkgInstance
+	parents       []*pkgInstance
+	nested        map[string]*pkgInstance // 因版本冲突嵌套安装在自身 node_modules 下的包
+}
+
+// link 记录一条依赖边
+func (p *pkgInstance) link(child *pkgInstance) {
+	p.deps = append(p.deps, child)
+	child.parents = append(child.parents, p)
+}
+
+// label 按 name@version 输出
+func (p *pkgInstance) label() string {
+	return p.name + "@" + p.version
+}
+
+// peerConflict npm 无法满足的 peer 依赖
+type peerConflict struct {
+	from  *pkgInstance
+	req   pkgReq
+	found *pkgInstance
+}
+
+// installStats 一次依赖安装的统计
+type installStats struct {
+	resolved int
+	deduped  int     // 复用已解析版本的依赖声明数
+	bytes    int     // 实际下载的字节数
+	elapsed  float64 // 模拟耗时（秒）
+}
+
+// download 模拟下载一个包并返回耗时（秒），parallel 是包管理器的并发下载数
+func (s *installStats) download(size, parallel int) float64 {
+	seconds := float64(rand.Intn(150)+30)/1000 + float64(size)/float64((rand.Intn(25)+5)<<20)
+	s.bytes += size
+	s.elapsed += seconds / float64(parallel)
+	return seconds
+}
+
+// packageSize 由包名和版本得到一个稳定的压缩包大小（字节）
+func packageSize(name, version string) int {
+	h := fnv.New32a()
+	h.Write([]byte(name + "@" + version))
+	sum := int(h.Sum32())
+	base := 4 << 10 << (sum % 9)
+	return base * (100 + (sum>>8)%100) / 100
+}
+
+// humanBytes 按 Cargo 的风格输出字节数
+func humanBytes(n int) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(n)/(1<<10))
+	}
+	return fmt.Sprintf("%d B", n)
+}
+
+// olderPatch 返回同一次版本线上更旧的补丁版本，用来模拟锁文件里的旧记录
+func olderPatch(version string) (string, bool) {
+	v := parseSemver(version)
+	if v.patch == 0 || v.pre != "" {
+		return "", false
+	}
+	old := fmt.Sprintf("%d.%d.%d", v.major, v.minor, v.patch-rand.Intn(min(v.patch, 3))-1)
+	if strings.HasPrefix(version, "v") {
+		old = "v" + old
+	}
+	return old, true
+}
+
+// staleEntries 随机挑选几个在旧锁文件里还是旧补丁版本的包
+func staleEntries(instances []*pkgInstance) map[*pkgInstance]string {
+	stale := map[*pkgInstance]string{}
+	want := rand.Intn(4)
+	for _, i := range rand.Perm(len(instances)) {
+		if len(stale) >= want {
+			break
+		}
+		if old, ok := olderPatch(instances[i].version); ok {
+			stale[instances[i]] = old
+		}
+	}
+	return stale
+}
+
+// mostShared 返回被最多包依赖的实例
+func mostShared(instances []*pkgInstance) *pkgInstance {
+	best := instances[0]
+	for _, p := range instances {
+		if len(p.parents) > len(best.parents) {
+			best = p
+		}
+	}
+	return best
+}
+
+// runDependencyInstall 按虚拟仓库的语言模拟 go mod download、npm install 或 cargo fetch
+func runDependencyInstall(config *SessionConfig) {
+	var stats installStats
+	switch config.repo.language {
+	case "rust":
+		fmt.Println(blue("📦 " + tr("dep.title", config.naming.name, "cargo")))
+		installCargo(config, &stats)
+	case "typescript", "vue":
+		fmt.Println(blue("📦 " + tr("dep.title", config.naming.name, "npm")))
+		installNpm(config, &stats)
+	default:
+		fmt.Println(blue("📦 " + tr("dep.title", config.naming.name, "go")))
+		installGo(config, &stats)
+	}
+
+	fmt.Printf("\n✅ %s\n", tr("dep.summary", trn("dep.packages", stats.resolved), formatInt(stats.deduped),
+		formatFloat(float64(stats.bytes)/(1<<20), 1), formatFloat(stats.elapsed, 2)))
+	if rand.Float32() < jargonDensity(config.jargonLevel) {
+		fmt.Printf("🧠 %s\n", tr("jargon.insight", generatePerformanceJargon(config.devType, config.jargonLevel)))
+	}
+}
+
+// goSumHash 生成 go.sum 中的 h1 哈希
+func goSumHash() string {
+	return "h1:" + base64.StdEncoding.EncodeToString(randomBytes(32))
+}
+
+// goRequirement go.mod 中的一条 require
+type goRequirement struct{ from, module, version string }
+
+// buildList 最小版本选择的结果
+type buildList struct {
+	selected   map[string]string // 模块到选中的版本
+	requiredBy map[string][]goRequirement
+	order      []string // 按遍历顺序排列的所有可达 module@version
+	edges      int
+}
+
+// selectMinimalVersions 最小版本选择：遍历从主模块可达的所有模块版本，每个模块取被要求的最高版本
+func selectMinimalVersions(ix packageIndex, mainModule string, reqs []pkgReq) buildList {
+	var queue []goRequirement
+	for _, r := range reqs {
+		queue = append(queue, goRequirement{mainModule, r.name, r.constraint})
+	}
+	bl := buildList{selected: map[string]string{}, requiredBy: map[string][]goRequirement{}}
+	visited := map[string]bool{}
+	for len(queue) > 0 {
+		r := queue[0]
+		queue = queue[1:]
+		bl.edges++
+		bl.requiredBy[r.module] = append(bl.requiredBy[r.module], r)
+		key := r.module + "@" + r.version
+		if visited[key] {
+			continue
+		}
+		visited[key] = true
+		bl.order = append(bl.order, key)
+		if cur, ok := bl.selected[r.module]; !ok || parseSemver(cur).compare(parseSemver(r.version)) < 0 {
+			bl.selected[r.module] = r.version
+		}
+		for _, dep := range ix.release(r.module, r.version).deps {
+			queue = append(queue, goRequirement{key, dep.name, dep.constraint})
+		}
+	}
+	return bl
+}
+
+// installGo 用最小版本选择解析模块图，再模拟 go mod download -x
+func installGo(config *SessionConfig, stats *installStats) {
+	var reqs []pkgReq
+	for _, d := range thirdPartyDeps["go"] {
+		reqs = append(reqs, pkgReq{name: d.name, constraint: d.version})
+	}
+	bl := selectMinimalVersions(newPackageIndex(goModuleIndex), config.naming.modulePath, reqs)
+	selected, requiredBy := bl.selected, bl.requiredBy
+	stats.resolved = len(selected)
+	stats.deduped = bl.edges - len(selected)
+
+	// 先下载构建列表中每个版本的 go.mod，再下载选中版本的源码包
+	fmt.Println(blue("$ go mod download -x"))
+	hit := rand.Float32()*0.5 + 0.3
+	fetch := func(module, version, ext string) {
+		if rand.Float32() < hit {
+			return
+		}
+		size := packageSize(module, version)
+		if ext == "mod" {
+			size = size/40 + 300
+		}
+		seconds := stats.download(size, 8)
+		fmt.Printf("# get https://proxy.golang.org/%s/@v/%s.%s: 200 OK (%.3fs)\n", module, version, ext, seconds)
+		time.Sleep(time.Duration(rand.Intn(40)+10) * time.Millisecond)
+	}
+	for _, key := range bl.order {
+		module, version, _ := strings.Cut(key, "@")
+		fetch(module, version, "mod")
+	}
+	modules := make([]string, 0, len(selected))
+	for m := range selected {
+		modules = append(modules, m)
+	}
+	sort.Strings(modules)
+	for _, m := range modules {
+		fetch(m, selected[m], "zip")
+	}
+
+	// 被不同版本要求最多的模块，展示最小版本选择的结果
+	shared := modules[0]
+	versions := func(m string) int {
+		seen := map[string]bool{}
+		for _, r := range requiredBy[m] {
+			seen[r.version] = true
+		}
+		return len(seen)
+	}
+	for _, m := range modules {
+		if versions(m) > versions(shared) {
+			shared = m
+		}
+	}
+	fmt.Println(blue(fmt.Sprintf("$ go mod graph | grep ' %s@'", shared)))
+	var lines []string
+	for _, r := range requiredBy[shared] {
+		lines = append(lines, fmt.Sprintf("%s %s@%s", r.from, r.module, r.version))
+	}
+	sort.Strings(lines)
+	for _, l := range lines {
+		fmt.Println(l)
+	}
+	fmt.Println(blue("$ go list -m " + shared))
+	fmt.Println(shared + " " + green(selected[shared]))
+	fmt.Printf("🔀 %s\n", tr("dep.mvs", shared, selected[shared], trn("dep.versions", versions(shared))))
+
+	// go.sum：选中的版本有源码和 go.mod 两行，其余版本只有 go.mod 一行
+	var instances []*pkgInstance
+	for _, m := range modules {
+		instances = append(instances, &pkgInstance{name: m, version: selected[m]})
+	}
+	stale := staleEntries(instances)
+	if len(stale) == 0 {
+		return
+	}
+	fmt.Println(blue("$ git diff --unified=0 go.sum | grep '^[-+][a-z]'"))
+	for _, p := range instances {
+		old, ok := stale[p]
+		if !ok {
+			continue
+		}
+		fmt.Println(red(fmt.Sprintf("-%s %s %s", p.name, old, goSumHash())))
+		fmt.Println(red(fmt.Sprintf("-%s %s/go.mod %s", p.name, old, goSumHash())))
+		fmt.Println(green(fmt.Sprintf("+%s %s %s", p.name, p.version, goSumHash())))
+		fmt.Println(green(fmt.Sprintf("+%s %s/go.mod %s", p.name, p.version, goSumHash())))
+	}
+	printDiffStat([]diffStatLine{{path: "go.sum", insertions: 2 * len(stale), deletions: 2 * len(stale)}})
+}
+
+// installNpm 模拟 npm install：提升到顶层的依赖被复用，冲突的版本嵌套安装，peer 依赖不满足时给出警告
+func installNpm(config *SessionConfig, stats *installStats) {
+	ix := newPackageIndex(npmIndex)
+	language := config.repo.language
+	var reqs []pkgReq
+	for _, d := range thirdPartyDeps[language] {
+		reqs = append(reqs, pkgReq{name: d.name, constraint: "^" + d.version})
+	}
+	command := "npm install"
+	extra := ""
+	if rand.Float32() < 0.3 {
+		extra = npmExtras[language]
+		reqs = append(reqs, pkgReq{name: extra, constraint: "^" + ix[extra][0].version})
+		command += " " + extra
+	}
+
+	root := &pkgInstance{name: config.naming.name, version: config.naming.version, nested: map[string]*pkgInstance{}}
+	instances, conflicts := resolveNpm(ix, root, reqs, stats)
+	stats.resolved = len(instances)
+
+	fmt.Println(blue("$ " + command))
+	for _, c := range conflicts {
+		printPeerConflict(c, root)
+	}
+
+	hit := rand.Float32()*0.5 + 0.3
+	added := 0
+	for _, p := range instances {
+		if extra != "" && !dependsOn(p, extra) {
+			continue
+		}
+		added++
+		if rand.Float32() >= hit {
+			stats.download(packageSize(p.name, p.version), 16)
+		}
+	}
+	time.Sleep(time.Duration(min(stats.elapsed, 3)*1000) * time.Millisecond)
+	if added == 1 {
+		fmt.Printf("\nadded 1 package, and audited %d packages in %ds\n\n", len(instances)+1, int(stats.elapsed+1))
+	} else {
+		fmt.Printf("\nadded %d packages, and audited %d packages in %ds\n\n", added, len(instances)+1, int(stats.elapsed+1))
+	}
+	funding := len(instances) * (rand.Intn(15) + 10) / 100
+	fmt.Printf("%d packages are looking for funding\n  run `npm fund` for details\n\n", funding)
+	fmt.Println("found " + green("0") + " vulnerabilities")
+
+	// 优先展示有多个版本共存的包，否则展示被依赖最多的包
+	var nested []string
+	seen := map[string]bool{}
+	for _, p := range instances {
+		if strings.Count(p.location, "node_modules") > 1 && !seen[p.name] {
+			seen[p.name] = true
+			nested = append(nested, p.name)
+		}
+	}
+	target := mostShared(instances)
+	if len(nested) > 0 {
+		name := nested[rand.Intn(len(nested))]
+		var copies []string
+		for _, p := range instances {
+			if p.name == name && !slices.Contains(copies, p.version) {
+				copies = append(copies, p.version)
+			}
+		}
+		printNpmLs(root, name, config.naming.repoDir)
+		fmt.Printf("🔀 %s\n", tr("dep.conflict", name, strings.Join(copies, ", ")))
+	} else {
+		printNpmLs(root, target.name, config.naming.repoDir)
+	}
+	fmt.Printf("♻️  %s\n", tr("dep.shared", target.label(), trn("dep.dependents", len(target.parents))))
+
+	// package-lock.json：每个新条目约 10 行，升级的条目改动 version、resolved 和 integrity 三行
+	stale := staleEntries(instances)
+	var diff []diffStatLine
+	if extra != "" {
+		diff = append(diff, diffStatLine{path: "package.json", insertions: 1})
+	}
+	lock := diffStatLine{path: "package-lock.json", insertions: 3 * len(stale), deletions: 3 * len(stale)}
+	if extra != "" {
+		lock.insertions += 10*added + 1
+	}
+	if lock.insertions > 0 {
+		fmt.Println(blue("$ git diff --stat"))
+		printDiffStat(append(diff, lock))
+	}
+}
+
+// resolveNpm 广度优先解析 npm 依赖：能复用顶层版本就复用，否则嵌套安装在依赖方目录下
+func resolveNpm(ix packageIndex, root *pkgInstance, reqs []pkgReq, stats *installStats) ([]*pkgInstance, []peerConflict) {
+	type pending struct {
+		parent *pkgInstance
+		req    pkgReq
+	}
+	var queue []pending
+	for _, r := range reqs {
+		queue = append(queue, pending{root, r})
+	}
+	top := map[string]*pkgInstance{}
+	var instances []*pkgInstance
+	var conflicts []peerConflict
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+		location := "node_modules/" + p.req.name
+		if cur, ok := top[p.req.name]; ok {
+			if satisfies(cur.version, p.req.constraint) {
+				p.parent.link(cur)
+				stats.deduped++
+				continue
+			}
+			// npm 7 之后 peer 依赖冲突只警告，沿用已安装的版本
+			if p.req.peer {
+				p.parent.link(cur)
+				conflicts = append(conflicts, peerConflict{from: p.parent, req: p.req, found: cur})
+				continue
+			}
+			if n, ok := p.parent.nested[p.req.name]; ok {
+				p.parent.link(n)
+				stats.deduped++
+				continue
+			}
+			location = p.parent.location + "/" + location
+		}
+		rel, ok := ix.resolve(p.req.name, p.req.constraint)
+		if !ok {
+			continue
+		}
+		inst := &pkgInstance{name: p.req.name, version: rel.version, location: location, nested: map[string]*pkgInstance{}}
+		if p.parent == root || top[p.req.name] == nil {
+			top[p.req.name] = inst
+		} else {
+			p.parent.nested[p.req.name] = inst
+		}
+		p.parent.link(inst)
+		instances = append(instances, inst)
+		for _, dep := range rel.deps {
+			queue = append(queue, pending{inst, dep})
+		}
+	}
+	return instances, conflicts
+}
+
+// dependsOn 判断实例是否只因 name 这个直接依赖才被安装（沿依赖方向上追溯到根）
+func dependsOn(p *pkgInstance, name string) bool {
+	seen := map[*pkgInstance]bool{}
+	var walk func(n *pkgInstance) bool
+	walk = func(n *pkgInstance) bool {
+		if n.name == name {
+			return true
+		}
+		if seen[n] || len(n.parents) == 0 {
+			return false
+		}
+		seen[n] = true
+		for _, parent := range n.parents {
+			if !walk(parent) {
+				return false
+			}
+		}
+		return true
+	}
+	return walk(p)
+}
+
+// printPeerConflict 按 npm 的 ERESOLVE 警告格式输出一个无法满足的 peer 依赖
+func printPeerConflict(c peerConflict, root *pkgInstance) {
+	requiredFrom := func(p *pkgInstance) string {
+		for _, parent := range p.parents {
+			if parent == root {
+				return "the root project"
+			}
+		}
+		return p.parents[0].label()
+	}
+	warn := func(format string, args ...any) {
+		fmt.Println(strings.TrimRight(yellow("npm warn")+" "+fmt.Sprintf(format, args...), " "))
+	}
+	warn("ERESOLVE overriding peer dependency")
+	warn("While resolving: %s", c.from.label())
+	warn("Found: %s", c.found.label())
+	warn("%s", c.found.location)
+	warn("  %s@\"^%s\" from %s", c.found.name, c.found.version, requiredFrom(c.found))
+	var others []string
+	for _, parent := range c.found.parents {
+		if parent != root && parent != c.from {
+			others = append(others, parent.name)
+		}
+	}
+	if more := len(others); more > 0 {
+		if more > 3 {
+			others = append(others[:3], "...")
+		}
+		warn("  %d more (%s)", more, strings.Join(others, ", "))
+	}
+	warn("")
+	warn("Could not resolve dependency:")
+	warn("peer %s@\"%s\" from %s", c.req.name, c.req.constraint, c.from.label())
+	warn("%s", c.from.location)
+	warn("  %s@\"^%s\" from %s", c.from.name, c.from.version, requiredFrom(c.from))
+}
+
+// printNpmLs 按 npm ls <name> 的格式输出所有通向 name 的依赖路径，重复出现的实例标记为 deduped
+func printNpmLs(root *pkgInstance, name, dir string) {
+	// 从目标实例沿依赖方向上回溯，得到所有位于路径上的实例
+	onPath := map[*pkgInstance]bool{}
+	var mark func(p *pkgInstance)
+	mark = func(p *pkgInstance) {
+		if onPath[p] {
+			return
+		}
+		onPath[p] = true
+		for _, parent := range p.parents {
+			mark(parent)
+		}
+	}
+	var visit func(p *pkgInstance, seen map[*pkgInstance]bool)
+	visit = func(p *pkgInstance, seen map[*pkgInstance]bool) {
+		if seen[p] {
+			return
+		}
+		seen[p] = true
+		if p.name == name {
+			mark(p)
+		}
+		for _, d := range p.deps {
+			visit(d, seen)
+		}
+	}
+	visit(root, map[*pkgInstance]bool{})
+
+	fmt.Println(blue("$ npm ls " + name))
+	fmt.Printf("%s %s\n", root.label(), dir)
+	printed := map[*pkgInstance]bool{}
+	var walk func(p *pkgInstance, prefix string)
+	walk = func(p *pkgInstance, prefix string) {
+		var children []*pkgInstance
+		for _, d := range p.deps {
+			if onPath[d] {
+				children = append(children, d)
+			}
+		}
+		for i, c := range children {
+			branch, indent := "├─", "│ "
+			if i == len(children)-1 {
+				branch, indent = "└─", "  "
+			}
+			label := c.label()
+			if c.name == name {
+				label = yellow(label)
+			}
+			expand := !printed[c] && c.name != name
+			switch {
+			case printed[c]:
+				fmt.Printf("%s%s─ %s deduped\n", prefix, branch, label)
+			case expand:
+				fmt.Printf("%s%s┬ %s\n", prefix, branch, label)
+			default:
+				fmt.Printf("%s%s─ %s\n", prefix, branch, label)
+			}
+			printed[c] = true
+			if expand {
+				walk(c, prefix+indent)
+			}
+		}
+	}
+	walk(root, "")
+}
+
+// installCargo 模拟 cargo fetch：同一个包在语义化兼容的范围内只保留一个版本
+func installCargo(config *SessionConfig, stats *installStats) {
+	ix := newPackageIndex(cargoIndex)
+	root := &pkgInstance{name: config.naming.name, version: config.naming.version}
+	type pending struct {
+		parent *pkgInstance
+		req    pkgReq
+	}
+	var queue []pending
+	for _, d := range thirdPartyDeps["rust"] {
+		queue = append(queue, pending{root, pkgReq{name: d.name, constraint: "^" + d.version}})
+	}
+	byName := map[string][]*pkgInstance{}
+	var instances []*pkgInstance
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+		var found *pkgInstance
+		for _, inst := range byName[p.req.name] {
+			if satisfies(inst.version, p.req.constraint) {
+				found = inst
+				break
+			}
+		}
+		if found != nil {
+			p.parent.link(found)
+			stats.deduped++
+			continue
+		}
+		rel, ok := ix.resolve(p.req.name, p.req.constraint)
+		if !ok {
+			continue
+		}
+		inst := &pkgInstance{name: p.req.name, version: rel.version}
+		byName[inst.name] = append(byName[inst.name], inst)
+		p.parent.link(inst)
+		instances = append(instances, inst)
+		for _, dep := range rel.deps {
+			queue = append(queue, pending{inst, dep})
+		}
+	}
+	stats.resolved = len(instances)
+
+	fmt.Println(blue("$ cargo fetch"))
+	status := func(verb, rest string) {
+		fmt.Printf("%s %s\n", green(fmt.Sprintf("%12s", verb)), rest)
+		time.Sleep(time.Duration(rand.Intn(40)+10) * time.Millisecond)
+	}
+	status("Updating", "crates.io index")
+	stale := staleEntries(instances)
+	if len(stale) > 0 {
+		if len(stale) == 1 {
+			status("Locking", "1 package to latest compatible version")
+		} else {
+			status("Locking", fmt.Sprintf("%d packages to latest compatible versions", len(stale)))
+		}
+		for _, p := range instances {
+			if old, ok := stale[p]; ok {
+				status("Updating", fmt.Sprintf("%s v%s -> v%s", p.name, old, p.version))
+			}
+		}
+	}
+	behind := 0
+	for _, p := range instances {
+		if ix[p.name][0].version != p.version {
+			behind++
+		}
+	}
+	if behind > 0 {
+		fmt.Printf("%s pass `--verbose` to see %d unchanged dependencies behind latest\n", green("note:"), behind)
+	}
+
+	// .crate 只包含源码，比 npm 包和 Go 模块的压缩包小得多
+	crateSize := func(p *pkgInstance) int { return packageSize(p.name, p.version) / 4 }
+	hit := rand.Float32()*0.5 + 0.3
+	downloaded := 0
+	var largest *pkgInstance
+	for _, p := range instances {
+		if rand.Float32() < hit {
+			continue
+		}
+		downloaded++
+		stats.download(crateSize(p), 8)
+		if largest == nil || crateSize(p) > crateSize(largest) {
+			largest = p
+		}
+		status("Downloaded", fmt.Sprintf("%s v%s", p.name, p.version))
+	}
+	if downloaded > 0 {
+		status("Downloaded", fmt.Sprintf("%d crates (%s) in %.2fs (largest was `%s` at %s)", downloaded,
+			humanBytes(stats.bytes), stats.elapsed, largest.name, humanBytes(crateSize(largest))))
+	}
+
+	// cargo tree -d：语义化不兼容的多个版本会同时编译进来
+	var dups []string
+	for name, list := range byName {
+		if len(list) > 1 {
+			dups = append(dups, name)
+		}
+	}
+	sort.Strings(dups)
+	if len(dups) > 0 {
+		fmt.Println(blue("$ cargo tree --duplicates"))
+		for _, name := range dups {
+			list := byName[name]
+			sort.Slice(list, func(i, j int) bool {
+				return parseSemver(list[i].version).compare(parseSemver(list[j].version)) < 0
+			})
+			var versions []string
+			for _, p := range list {
+				versions = append(versions, p.version)
+				printInvertedTree(p, root, config.naming.repoDir)
+				fmt.Println()
+			}
+			fmt.Printf("🔀 %s\n", tr("dep.conflict", name, strings.Join(versions, ", ")))
+		}
+	}
+	shared := mostShared(instances)
+	fmt.Printf("♻️  %s\n", tr("dep.shared", shared.name+" v"+shared.version, trn("dep.dependents", len(shared.parents))))
+
+	// Cargo.lock：每个升级的条目改动 version 和 checksum 两行
+	if len(stale) > 0 {
+		fmt.Println(blue("$ git diff --stat"))
+		printDiffStat([]diffStatLine{{path: "Cargo.lock", insertions: 2 * len(stale), deletions: 2 * len(stale)}})
+	}
+}
+
+// printInvertedTree 按 cargo tree --invert 的格式输出依赖某个包的所有路径，已输出过的子树标记为 (*)
+func printInvertedTree(p, root *pkgInstance, dir string) {
+	fmt.Println(yellow(fmt.Sprintf("%s v%s", p.name, p.version)))
+	printed := map[*pkgInstance]bool{}
+	var walk func(n *pkgInstance, prefix string)
+	walk = func(n *pkgInstance, prefix string) {
+		parents := map[*pkgInstance]bool{}
+		var unique []*pkgInstance
+		for _, parent := range n.parents {
+			if !parents[parent] {
+				parents[parent] = true
+				unique = append(unique, parent)
+			}
+		}
+		for i, parent := range unique {
+			branch, indent := "├── ", "│   "
+			if i == len(unique)-1 {
+				branch, indent = "└── ", "    "
+			}
+			label := fmt.Sprintf("%s v%s", parent.name, parent.version)
+			if parent == root {
+				label += " (" + dir + ")"
+			}
+			if printed[parent] && len(parent.parents) > 0 {
+				fmt.Println(prefix + branch + label + " (*)")
+				continue
+			}
+			fmt.Println(prefix + branch + label)
+			printed[parent] = true
+			walk(parent, prefix+indent)
+		}
+	}
+	walk(p, "")
+}
+
+// 会话中偶尔新增的 npm 依赖，它们的 peer 依赖与项目里的框架版本冲突
+var npmExtras = map[string]string{
+	"typescript": "react-beautiful-dnd",
+	"vue":        "@vue/composition-api",
+}
+
+// Go 模块注册表：每个版本的 go.mod 中的 require
+var goModuleIndex = map[string]string{
+	"github.com/go-chi/chi/v5@v5.1.0":                                              "",
+	"github.com/jackc/pgx/v5@v5.6.0":                                               "github.com/jackc/pgpassfile v1.0.0, github.com/jackc/pgservicefile v0.0.0-20221227161230-091c0ba34f0a, github.com/jackc/puddle/v2 v2.2.1, golang.org/x/crypto v0.17.0, golang.org/x/text v0.14.0, golang.org/x/sync v0.1.0",
+	"github.com/jackc/puddle/v2@v2.2.1":                                            "golang.org/x/sync v0.1.0",
+	"github.com/redis/go-redis/v9@v9.6.1":                                          "github.com/cespare/xxhash/v2 v2.2.0, github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f",
+	"go.uber.org/zap@v1.27.0":                                                      "go.uber.org/multierr v1.10.0",
+	"go.uber.org/multierr@v1.10.0":                                                 "",
+	"go.opentelemetry.io/otel@v1.28.0":                                             "github.com/go-logr/logr v1.4.2, github.com/go-logr/stdr v1.2.2, go.opentelemetry.io/otel/metric v1.28.0, go.opentelemetry.io/otel/trace v1.28.0",
+	"go.opentelemetry.io/otel/metric@v1.28.0":                                      "go.opentelemetry.io/otel v1.28.0",
+	"go.opentelemetry.io/otel/trace@v1.28.0":                                       "go.opentelemetry.io/otel v1.28.0",
+	"github.com/go-logr/stdr@v1.2.2":                                               "github.com/go-logr/logr v1.2.2",
+	"google.golang.org/grpc@v1.65.0":                                               "golang.org/x/net v0.25.0, golang.org/x/sys v0.20.0, golang.org/x/text v0.15.0, google.golang.org/genproto/googleapis/rpc v0.0.0-20240528184218-531527333157, google.golang.org/protobuf v1.34.1",
+	"google.golang.org/genproto/googleapis/rpc@v0.0.0-20240528184218-531527333157": "google.golang.org/protobuf v1.34.1",
+	"google.golang.org/protobuf@v1.34.2":                                           "",
+	"github.com/prometheus/client_golang@v1.19.1":                                  "github.com/beorn7/perks v1.0.1, github.com/cespare/xxhash/v2 v2.2.0, github.com/prometheus/client_model v0.5.0, github.com/prometheus/common v0.48.0, github.com/prometheus/procfs v0.12.0, golang.org/x/sys v0.17.0, google.golang.org/protobuf v1.33.0",
+	"github.com/prometheus/common@v0.48.0":                                         "github.com/prometheus/client_model v0.5.0, google.golang.org/protobuf v1.32.0",
+	"github.com/prometheus/client_model@v0.5.0":                                    "google.golang.org/protobuf v1.30.0",
+	"github.com/prometheus/procfs@v0.12.0":                                         "golang.org/x/sys v0.15.0",
+	"github.com/spf13/viper@v1.19.0":                                               "github.com/fsnotify/fsnotify v1.7.0, github.com/mitchellh/mapstructure v1.5.0, github.com/pelletier/go-toml/v2 v2.2.2, github.com/spf13/afero v1.11.0, github.com/spf13/cast v1.6.0, github.com/spf13/pflag v1.0.5, github.com/subosito/gotenv v1.6.0, gopkg.in/yaml.v3 v3.0.1, golang.org/x/text v0.14.0",
+	"github.com/fsnotify/fsnotify@v1.7.0":                                          "golang.org/x/sys v0.4.0",
+	"github.com/spf13/afero@v1.11.0":                                               "golang.org/x/text v0.14.0",
+	"golang.org/x/sync@v0.8.0":                                                     "",
+	"golang.org/x/net@v0.25.0":                                                     "golang.org/x/crypto v0.23.0, golang.org/x/sys v0.20.0, golang.org/x/text v0.15.0",
+	"golang.org/x/net@v0.27.0":                                                     "golang.org/x/crypto v0.25.0, golang.org/x/sys v0.22.0, golang.org/x/text v0.16.0",
+	"golang.org/x/crypto@v0.25.0":                                                  "golang.org/x/net v0.21.0, golang.org/x/sys v0.22.0",
+	"github.com/stretchr/testify@v1.9.0":                                           "github.com/davecgh/go-spew v1.1.1, github.com/pmezard/go-difflib v1.0.0, github.com/stretchr/objx v0.5.2, gopkg.in/yaml.v3 v3.0.1",
+	"github.com/stretchr/objx@v0.5.2":                                              "github.com/stretchr/testify v1.8.4",
+}
+
+// npm 注册表：dependencies 和 peerDependencies
+var npmIndex = map[string]string{
+	// React 应用
+	"react@18.3.1":                  "loose-envify ^1.1.0",
+	"react-dom@18.3.1":              "loose-envify ^1.1.0, scheduler ^0.23.2, peer react ^18.3.1",
+	"loose-envify@1.4.0":            "js-tokens ^3.0.0 || ^4.0.0",
+	"js-tokens@4.0.0":               "",
+	"scheduler@0.23.2":              "loose-envify ^1.1.0",
+	"@tanstack/react-query@5.51.11": "@tanstack/query-core 5.51.9, peer react ^18 || ^19",
+	"@tanstack/query-core@5.51.9":   "",
+	"zustand@4.5.4":                 "use-sync-external-store 1.2.0, peer react >=16.8",
+	"use-sync-external-store@1.2.0": "peer react ^16.8.0 || ^17.0.0 || ^18.0.0",
+	"axios@1.7.2":                   "follow-redirects ^1.15.6, form-data ^4.0.0, proxy-from-env ^1.1.0",
+	"follow-redirects@1.15.6":       "",
+	"form-data@4.0.0":               "asynckit ^0.4.0, combined-stream ^1.0.8, mime-types ^2.1.12",
+	"asynckit@0.4.0":                "",
+	"combined-stream@1.0.8":         "delayed-stream ~1.0.0",
+	"delayed-stream@1.0.0":          "",
+	"mime-types@2.1.35":             "mime-db 1.52.0",
+	"mime-db@1.52.0":                "",
+	"proxy-from-env@1.1.0":          "",
+	"zod@3.23.8":                    "",
+	"date-fns@3.6.0":                "",
+	"typescript@5.5.4":              "",
+
+	// 构建和测试工具
+	"vite@5.3.5":                                "esbuild ^0.21.3, postcss ^8.4.39, rollup ^4.13.0",
+	"esbuild@0.21.5":                            "",
+	"postcss@8.4.40":                            "nanoid ^3.3.7, picocolors ^1.0.1, source-map-js ^1.2.0",
+	"nanoid@3.3.7":                              "",
+	"picocolors@1.0.1":                          "",
+	"source-map-js@1.2.0":                       "",
+	"rollup@4.19.1":                             "@types/estree 1.0.5",
+	"@types/estree@1.0.5":                       "",
+	"vitest@2.0.4":                              "@vitest/expect 2.0.4, @vitest/runner 2.0.4, chai ^5.1.1, debug ^4.3.5, magic-string ^0.30.10, pathe ^1.1.2, picocolors ^1.0.1, tinyrainbow ^1.2.0, vite ^5.0.0",
+	"@vitest/expect@2.0.4":                      "chai ^5.1.1, tinyrainbow ^1.2.0",
+	"@vitest/runner@2.0.4":                      "pathe ^1.1.2",
+	"chai@5.1.1":                                "assertion-error ^2.0.1, check-error ^2.1.1, deep-eql ^5.0.1, loupe ^3.1.0, pathval ^2.0.0",
+	"assertion-error@2.0.1":                     "",
+	"check-error@2.1.1":                         "",
+	"deep-eql@5.0.2":                            "",
+	"loupe@3.1.1":                               "",
+	"pathval@2.0.0":                             "",
+	"debug@4.3.6":                               "ms 2.1.2",
+	"ms@2.1.2":                                  "",
+	"ms@2.1.3":                                  "",
+	"magic-string@0.30.10":                      "@jridgewell/sourcemap-codec ^1.4.15",
+	"@jridgewell/sourcemap-codec@1.5.0":         "",
+	"pathe@1.1.2":                               "",
+	"tinyrainbow@1.2.0":                         "",
+	"eslint@9.8.0":                              "@eslint/js 9.8.0, @eslint/eslintrc ^3.1.0, ajv ^6.12.4, chalk ^4.0.0, debug ^4.3.2, espree ^10.1.0, eslint-visitor-keys ^4.0.0, esquery ^1.5.0, minimatch ^3.1.2, natural-compare ^1.4.0",
+	"@eslint/js@9.8.0":                          "",
+	"@eslint/eslintrc@3.1.0":                    "ajv ^6.12.4, debug ^4.3.2, espree ^10.0.1, globals ^14.0.0, minimatch ^3.1.2",
+	"ajv@6.12.6":                                "fast-deep-equal ^3.1.1, fast-json-stable-stringify ^2.0.0, json-schema-traverse ^0.4.1, uri-js ^4.2.2",
+	"fast-deep-equal@3.1.3":                     "",
+	"fast-json-stable-stringify@2.1.0":          "",
+	"json-schema-traverse@0.4.1":                "",
+	"uri-js@4.4.1":                              "punycode ^2.1.0",
+	"punycode@2.3.1":                            "",
+	"chalk@4.1.2":                               "ansi-styles ^4.1.0, supports-color ^7.1.0",
+	"chalk@2.4.2":                               "ansi-styles ^3.2.1, escape-string-regexp ^1.0.5, supports-color ^5.3.0",
+	"ansi-styles@5.2.0":                         "",
+	"ansi-styles@4.3.0":                         "color-convert ^2.0.1",
+	"ansi-styles@3.2.1":                         "color-convert ^1.9.0",
+	"color-convert@2.0.1":                       "color-name ~1.1.4",
+	"color-convert@1.9.3":                       "color-name 1.1.3",
+	"color-name@1.1.4":                          "",
+	"color-name@1.1.3":                          "",
+	"escape-string-regexp@1.0.5":                "",
+	"supports-color@7.2.0":                      "has-flag ^4.0.0",
+	"supports-color@5.5.0":                      "has-flag ^3.0.0",
+	"has-flag@4.0.0":                            "",
+	"has-flag@3.0.0":                            "",
+	"espree@10.1.0":                             "acorn ^8.12.0, acorn-jsx ^5.3.2, eslint-visitor-keys ^4.0.0",
+	"espree@9.6.1":                              "acorn ^8.9.0, acorn-jsx ^5.3.2, eslint-visitor-keys ^3.4.1",
+	"acorn@8.12.1":                              "",
+	"acorn-jsx@5.3.2":                           "peer acorn ^6.0.0 || ^7.0.0 || ^8.0.0",
+	"eslint-visitor-keys@4.0.0":                 "",
+	"eslint-visitor-keys@3.4.3":                 "",
+	"esquery@1.6.0":                             "estraverse ^5.1.0",
+	"estraverse@5.3.0":                          "",
+	"minimatch@3.1.2":                           "brace-expansion ^1.1.7",
+	"brace-expansion@1.1.11":                    "balanced-match ^1.0.0, concat-map 0.0.1",
+	"balanced-match@1.0.2":                      "",
+	"concat-map@0.0.1":                          "",
+	"natural-compare@1.4.0":                     "",
+	"globals@14.0.0":                            "",
+	"globals@13.24.0":                           "type-fest ^0.20.2",
+	"type-fest@0.20.2":                          "",
+	"@testing-library/react@16.0.0":             "@babel/runtime ^7.12.5, peer @testing-library/dom ^10.0.0, peer react ^18.0.0, peer react-dom ^18.0.0",
+	"@testing-library/dom@10.4.0":               "@babel/code-frame ^7.10.4, @babel/runtime ^7.12.5, aria-query 5.3.0, chalk ^4.1.0, dom-accessibility-api ^0.5.9, lz-string ^1.5.0, pretty-format ^27.0.2",
+	"@babel/runtime@7.25.0":                     "regenerator-runtime ^0.14.0",
+	"regenerator-runtime@0.14.1":                "",
+	"@babel/code-frame@7.24.7":                  "@babel/highlight ^7.24.7, picocolors ^1.0.0",
+	"@babel/highlight@7.24.7":                   "@babel/helper-validator-identifier ^7.24.7, chalk ^2.4.2, js-tokens ^4.0.0, picocolors ^1.0.0",
+	"@babel/helper-validator-identifier@7.24.7": "",
+	"aria-query@5.3.0":                          "dequal ^2.0.3",
+	"dequal@2.0.3":                              "",
+	"dom-accessibility-api@0.5.16":              "",
+	"lz-string@1.5.0":                           "",
+	"pretty-format@27.5.1":                      "ansi-regex ^5.0.1, ansi-styles ^5.0.0, react-is ^17.0.1",
+	"ansi-regex@5.0.1":                          "",
+	"react-is@17.0.2":                           "",
+
+	// Vue 应用
+	"vue@3.4.34":                           "@vue/compiler-dom 3.4.34, @vue/compiler-sfc 3.4.34, @vue/runtime-dom 3.4.34, @vue/server-renderer 3.4.34, @vue/shared 3.4.34",
+	"@vue/shared@3.4.34":                   "",
+	"@vue/compiler-core@3.4.34":            "@babel/parser ^7.24.7, @vue/shared 3.4.34, entities ^4.5.0, estree-walker ^2.0.2, source-map-js ^1.2.0",
+	"@vue/compiler-dom@3.4.34":             "@vue/compiler-core 3.4.34, @vue/shared 3.4.34",
+	"@vue/compiler-sfc@3.4.34":             "@babel/parser ^7.24.7, @vue/compiler-core 3.4.34, @vue/compiler-dom 3.4.34, @vue/compiler-ssr 3.4.34, @vue/shared 3.4.34, estree-walker ^2.0.2, magic-string ^0.30.10, postcss ^8.4.39, source-map-js ^1.2.0",
+	"@vue/compiler-ssr@3.4.34":             "@vue/compiler-dom 3.4.34, @vue/shared 3.4.34",
+	"@vue/reactivity@3.4.34":               "@vue/shared 3.4.34",
+	"@vue/runtime-core@3.4.34":             "@vue/reactivity 3.4.34, @vue/shared 3.4.34",
+	"@vue/runtime-dom@3.4.34":              "@vue/reactivity 3.4.34, @vue/runtime-core 3.4.34, @vue/shared 3.4.34, csstype ^3.1.3",
+	"@vue/server-renderer@3.4.34":          "@vue/compiler-ssr 3.4.34, @vue/shared 3.4.34, peer vue 3.4.34",
+	"@babel/parser@7.25.0":                 "@babel/types ^7.25.0",
+	"@babel/types@7.25.0":                  "@babel/helper-string-parser ^7.24.8, @babel/helper-validator-identifier ^7.24.7, to-fast-properties ^2.0.0",
+	"@babel/helper-string-parser@7.24.8":   "",
+	"to-fast-properties@2.0.0":             "",
+	"entities@4.5.0":                       "",
+	"estree-walker@2.0.2":                  "",
+	"csstype@3.1.3":                        "",
+	"vue-router@4.4.0":                     "@vue/devtools-api ^6.5.1, peer vue ^3.2.0",
+	"@vue/devtools-api@6.6.3":              "",
+	"pinia@2.2.0":                          "@vue/devtools-api ^6.6.3, vue-demi ^0.14.8, peer vue ^2.6.14 || ^3.3.0",
+	"vue-demi@0.14.10":                     "peer vue ^3.0.0 || ^2.6.0",
+	"eslint-plugin-vue@9.27.0":             "@eslint-community/eslint-utils ^4.4.0, globals ^13.24.0, natural-compare ^1.4.0, nth-check ^2.1.1, postcss-selector-parser ^6.0.15, semver ^7.6.0, vue-eslint-parser ^9.4.3, xml-name-validator ^4.0.0, peer eslint ^6.2.0 || ^7.0.0 || ^8.0.0 || ^9.0.0",
+	"@eslint-community/eslint-utils@4.4.0": "eslint-visitor-keys ^3.3.0, peer eslint ^6.0.0 || ^7.0.0 || >=8.0.0",
+	"nth-check@2.1.1":                      "boolbase ^1.0.0",
+	"boolbase@1.0.0":                       "",
+	"postcss-selector-parser@6.1.1":        "cssesc ^3.0.0, util-deprecate ^1.0.2",
+	"cssesc@3.0.0":                         "",
+	"util-deprecate@1.0.2":                 "",
+	"semver@7.6.3":                         "",
+	"xml-name-validator@4.0.0":             "",
+	"vue-eslint-parser@9.4.3":              "debug ^4.3.4, eslint-scope ^7.1.1, eslint-visitor-keys ^3.3.0, espree ^9.3.1, esquery ^1.4.0, lodash ^4.17.21, semver ^7.3.6, peer eslint >=6.0.0",
+	"eslint-scope@7.2.2":                   "esrecurse ^4.3.0, estraverse ^5.2.0",
+	"esrecurse@4.3.0":                      "estraverse ^5.2.0",
+	"lodash@4.17.21":                       "",
+
+	// 偶尔新增的依赖
+	"react-beautiful-dnd@13.1.1": "@babel/runtime ^7.9.2, css-box-model ^1.2.0, memoize-one ^5.1.1, raf-schd ^4.0.2, redux ^4.0.4, use-memo-one ^1.1.1, peer react ^16.8.5 || ^17.0.0, peer react-dom ^16.8.5 || ^17.0.0",
+	"css-box-model@1.2.1":        "tiny-invariant ^1.0.6",
+	"tiny-invariant@1.3.3":       "",
+	"memoize-one@5.2.1":          "",
+	"raf-schd@4.0.3":             "",
+	"redux@4.2.1":                "@babel/runtime ^7.9.2",
+	"use-memo-one@1.1.3":         "peer react ^16.8.0 || ^17.0.0 || ^18.0.0",
+	"@vue/composition-api@1.7.2": "peer vue >=2.5.0 <2.7.0",
+}
+
+// crates.io 注册表：Cargo.toml 中的 dependencies
+var cargoIndex = map[string]string{
+	"tokio@1.39.2":                 "bytes ^1.1.0, libc ^0.2.153, mio ^1.0.1, pin-project-lite ^0.2.11, socket2 ^0.5.5, tokio-macros ^2.4.0",
+	"tokio-macros@2.4.0":           "proc-macro2 ^1.0.60, quote ^1.0.20, syn ^2.0.19",
+	"mio@1.0.1":                    "libc ^0.2.149, log ^0.4.8",
+	"socket2@0.5.7":                "libc ^0.2.150",
+	"bytes@1.6.1":                  "",
+	"libc@0.2.155":                 "",
+	"log@0.4.22":                   "",
+	"pin-project-lite@0.2.14":      "",
+	"proc-macro2@1.0.86":           "unicode-ident ^1.0",
+	"unicode-ident@1.0.12":         "",
+	"quote@1.0.36":                 "proc-macro2 ^1.0.74",
+	"syn@2.0.72":                   "proc-macro2 ^1.0.83, quote ^1.0.35, unicode-ident ^1.0",
+	"serde@1.0.204":                "serde_derive =1.0.204",
+	"serde_derive@1.0.204":         "proc-macro2 ^1.0.74, quote ^1.0.35, syn ^2.0.46",
+	"serde_json@1.0.120":           "itoa ^1.0, memchr ^2.7, ryu ^1.0, serde ^1.0.194",
+	"itoa@1.0.11":                  "",
+	"ryu@1.0.18":                   "",
+	"memchr@2.7.4":                 "",
+	"thiserror@1.0.63":             "thiserror-impl =1.0.63",
+	"thiserror-impl@1.0.63":        "proc-macro2 ^1.0.74, quote ^1.0.35, syn ^2.0.66",
+	"anyhow@1.0.86":                "",
+	"tracing@0.1.40":               "log ^0.4.17, pin-project-lite ^0.2.9, tracing-attributes ^0.1.27, tracing-core ^0.1.32",
+	"tracing-attributes@0.1.27":    "proc-macro2 ^1.0.60, quote ^1.0.20, syn ^2.0",
+	"tracing-core@0.1.32":          "once_cell ^1.13.0",
+	"once_cell@1.19.0":             "",
+	"parking_lot@0.12.3":           "lock_api ^0.4.6, parking_lot_core ^0.9.6",
+	"lock_api@0.4.12":              "autocfg ^1.1.0, scopeguard ^1.1.0",
+	"autocfg@1.3.0":                "",
+	"scopeguard@1.2.0":             "",
+	"parking_lot_core@0.9.10":      "cfg-if ^1.0.0, libc ^0.2.95, smallvec ^1.6.1",
+	"cfg-if@1.0.0":                 "",
+	"smallvec@1.13.2":              "",
+	"futures@0.3.30":               "futures-channel ^0.3.30, futures-core ^0.3.30, futures-executor ^0.3.30, futures-io ^0.3.30, futures-sink ^0.3.30, futures-task ^0.3.30, futures-util ^0.3.30",
+	"futures-channel@0.3.30":       "futures-core ^0.3.30, futures-sink ^0.3.30",
+	"futures-core@0.3.30":          "",
+	"futures-executor@0.3.30":      "futures-core ^0.3.30, futures-task ^0.3.30, futures-util ^0.3.30",
+	"futures-io@0.3.30":            "",
+	"futures-sink@0.3.30":          "",
+	"futures-task@0.3.30":          "",
+	"futures-util@0.3.30":          "futures-channel ^0.3.30, futures-core ^0.3.30, futures-io ^0.3.30, futures-macro =0.3.30, futures-sink ^0.3.30, futures-task ^0.3.30, memchr ^2.2, pin-project-lite ^0.2.6, pin-utils ^0.1.0, slab ^0.4.2",
+	"futures-macro@0.3.30":         "proc-macro2 ^1.0.60, quote ^1.0, syn ^2.0.52",
+	"pin-utils@0.1.0":              "",
+	"slab@0.4.9":                   "autocfg ^1",
+	"hyper@1.4.1":                  "bytes ^1.2, futures-channel ^0.3, futures-util ^0.3, h2 ^0.4, http ^1, http-body ^1, httparse ^1.8, httpdate ^1.0, itoa ^1, pin-project-lite ^0.2.4, smallvec ^1.12, tokio ^1, want ^0.3",
+	"h2@0.4.5":                     "bytes ^1, fnv ^1.0.5, futures-core ^0.3, futures-sink ^0.3, http ^1.1.0, indexmap ^2, slab ^0.4.2, tokio ^1, tokio-util ^0.7.1, tracing ^0.1.35",
+	"tokio-util@0.7.11":            "bytes ^1.0.0, futures-core ^0.3.0, futures-sink ^0.3.0, pin-project-lite ^0.2.11, tokio ^1.28.0",
+	"http@1.1.0":                   "bytes ^1, fnv ^1.0.5, itoa ^1",
+	"http-body@1.0.1":              "bytes ^1, http ^1",
+	"httparse@1.9.4":               "",
+	"httpdate@1.0.3":               "",
+	"fnv@1.0.7":                    "",
+	"want@0.3.1":                   "try-lock ^0.2.4",
+	"try-lock@0.2.5":               "",
+	"indexmap@2.2.6":               "equivalent ^1.0, hashbrown ^0.14.1",
+	"indexmap@1.9.3":               "autocfg ^1.1, hashbrown ^0.12",
+	"hashbrown@0.14.5":             "ahash ^0.8.6, allocator-api2 ^0.2.9",
+	"hashbrown@0.12.3":             "",
+	"equivalent@1.0.1":             "",
+	"ahash@0.8.11":                 "cfg-if ^1.0, once_cell ^1.18.0, zerocopy ^0.7.31",
+	"zerocopy@0.7.35":              "",
+	"allocator-api2@0.2.18":        "",
+	"tower@0.4.13":                 "futures-core ^0.3, futures-util ^0.3, indexmap ^1.0.2, pin-project ^1.0, pin-project-lite ^0.2.7, tokio ^1.6, tokio-util ^0.7.0, tower-layer ^0.3.1, tower-service ^0.3.1, tracing ^0.1.2",
+	"pin-project@1.1.5":            "pin-project-internal =1.1.5",
+	"pin-project-internal@1.1.5":   "proc-macro2 ^1.0, quote ^1.0, syn ^2.0",
+	"tower-layer@0.3.2":            "",
+	"tower-service@0.3.2":          "",
+	"axum@0.7.5":                   "axum-core ^0.4.3, bytes ^1.0, futures-util ^0.3, http ^1.0.0, http-body ^1.0.0, hyper ^1.1.0, itoa ^1.0.5, matchit ^0.7, memchr ^2.4.1, mime ^0.3.16, percent-encoding ^2.1, pin-project-lite ^0.2.7, serde ^1.0, tower ^0.4.13, tower-layer ^0.3.2, tower-service ^0.3",
+	"axum-core@0.4.3":              "bytes ^1.0, futures-util ^0.3, http ^1.0.0, http-body ^1.0.0, mime ^0.3.16, pin-project-lite ^0.2.7, tower-layer ^0.3, tower-service ^0.3",
+	"matchit@0.7.3":                "",
+	"mime@0.3.17":                  "",
+	"percent-encoding@2.3.1":       "",
+	"sqlx@0.8.0":                   "sqlx-core =0.8.0, sqlx-macros =0.8.0",
+	"sqlx-core@0.8.0":              "bytes ^1.1.0, futures-core ^0.3.19, futures-util ^0.3.19, hashbrown ^0.14.0, indexmap ^2.0, log ^0.4.18, once_cell ^1.9.0, percent-encoding ^2.1.0, serde ^1.0.132, serde_json ^1.0.73, smallvec ^1.7.0, thiserror ^1.0.30, tokio ^1, tracing ^0.1.37, url ^2.2.2",
+	"sqlx-macros@0.8.0":            "proc-macro2 ^1.0.79, quote ^1.0.26, sqlx-core =0.8.0, syn ^2.0.52",
+	"url@2.5.2":                    "form_urlencoded ^1.2.1, idna ^0.5.0, percent-encoding ^2.3.1",
+	"form_urlencoded@1.2.1":        "percent-encoding ^2.3.1",
+	"idna@0.5.0":                   "unicode-bidi ^0.3, unicode-normalization ^0.1.22",
+	"unicode-bidi@0.3.15":          "",
+	"unicode-normalization@0.1.23": "tinyvec ^1",
+	"tinyvec@1.8.0":                "tinyvec_macros ^0.1",
+	"tinyvec_macros@0.1.1":         "",
+	"clap@4.5.11":                  "clap_builder =4.5.11, clap_derive =4.5.11",
+	"clap_builder@4.5.11":          "anstream ^0.6.7, anstyle ^1.0.8, clap_lex ^0.7.0, strsim ^0.11",
+	"anstream@0.6.15":              "anstyle ^1.0.0, anstyle-parse ^0.2.0, colorchoice ^1.0.0, utf8parse ^0.2.1",
+	"anstyle@1.0.8":                "",
+	"anstyle-parse@0.2.5":          "utf8parse ^0.2.1",
+	"colorchoice@1.0.2":            "",
+	"utf8parse@0.2.2":              "",
+	"clap_lex@0.7.2":               "",
+	"strsim@0.11.1":                "",
+	"clap_derive@4.5.11":           "heck ^0.5.0, proc-macro2 ^1.0.69, quote ^1.0.9, syn ^2.0",
+	"heck@0.5.0":                   "",
+	"proptest@1.5.0":               "bitflags ^2, lazy_static ^1.1, num-traits ^0.2.15, rand ^0.8, rand_chacha ^0.3, rand_xorshift ^0.3, regex-syntax ^0.8, unarray ^0.1.4",
+	"bitflags@2.6.0":               "",
+	"lazy_static@1.5.0":            "",
+	"num-traits@0.2.19":            "autocfg ^1",
+	"rand@0.8.5":                   "libc ^0.2.22, rand_chacha ^0.3.0, rand_core ^0.6.0",
+	"rand_chacha@0.3.1":            "ppv-lite86 ^0.2.8, rand_core ^0.6.0",
+	"rand_core@0.6.4":              "getrandom ^0.2",
+	"getrandom@0.2.15":             "cfg-if ^1, libc ^0.2.154",
+	"ppv-lite86@0.2.17":            "",
+	"rand_xorshift@0.3.0":          "rand_core ^0.6",
+	"regex-syntax@0.8.4":           "",
+	"unarray@0.1.4":                "",
+}
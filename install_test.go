@@ -0,0 +1,165 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSatisfies(t *testing.T) {
+	tests := []struct {
+		version, constraint string
+		want                bool
+	}{
+		// ^ 允许不改变最左侧非零版本号的升级
+		{"1.2.3", "^1.2.3", true},
+		{"1.9.0", "^1.2.3", true},
+		{"2.0.0", "^1.2.3", false},
+		{"1.2.2", "^1.2.3", false},
+		{"0.2.5", "^0.2.3", true},
+		{"0.3.0", "^0.2.3", false},
+		{"0.0.3", "^0.0.3", true},
+		{"0.0.4", "^0.0.3", false},
+		// ~ 只允许补丁版本的升级
+		{"1.2.9", "~1.2.3", true},
+		{"1.3.0", "~1.2.3", false},
+		{"0.2.9", "~0.2.3", true},
+		// || 和空格分隔的比较符
+		{"3.0.0", "^3.0.0 || ^4.0.0", true},
+		{"4.0.1", "^3.0.0 || ^4.0.0", true},
+		{"5.0.0", "^3.0.0 || ^4.0.0", false},
+		{"1.5.0", ">=1.2.0 <2.0.0", true},
+		{"2.0.0", ">=1.2.0 <2.0.0", false},
+		{"7.1.0", "*", true},
+		{"1.2.3", "1.2.3", true},
+		{"1.2.4", "=1.2.3", false},
+		{"v1.2.3", "^1.2.0", true},
+		// 预发布版本只匹配同一版本号上带预发布标记的比较符
+		{"1.3.0-beta.1", "^1.2.0", false},
+		{"2.0.0-rc.1", "<2.0.0", false},
+		{"1.2.3-beta.2", "^1.2.3-beta.1", true},
+		{"1.2.3-alpha", "^1.2.3-beta.1", false},
+		{"1.2.3", "^1.2.3-beta.1", true},
+		{"1.2.4-beta.1", "^1.2.3-beta.1", false},
+		{"1.2.3-beta.1", ">=1.2.3-beta.1 <2.0.0", true},
+		{"1.0.0+build.5", "^1.0.0", true},
+	}
+	for _, tt := range tests {
+		if got := satisfies(tt.version, tt.constraint); got != tt.want {
+			t.Errorf("satisfies(%q, %q) = %v, want %v", tt.version, tt.constraint, got, tt.want)
+		}
+	}
+}
+
+func TestSemverCompare(t *testing.T) {
+	// 按从小到大排列
+	ordered := []string{
+		"0.0.0-20200823014737-9f7001d12a5f",
+		"0.0.0-20221227161230-091c0ba34f0a",
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.1",
+		"1.10.0",
+		"v2.0.0",
+	}
+	for i := range ordered {
+		for j := range ordered {
+			got := parseSemver(ordered[i]).compare(parseSemver(ordered[j]))
+			if (i < j && got >= 0) || (i > j && got <= 0) || (i == j && got != 0) {
+				t.Errorf("compare(%q, %q) = %d", ordered[i], ordered[j], got)
+			}
+		}
+	}
+}
+
+func TestResolveNpm(t *testing.T) {
+	ix := newPackageIndex(map[string]string{
+		"app-a@1.0.0": "shared ^1.0.0",
+		"app-b@1.0.0": "shared ^2.0.0",
+		"app-c@1.0.0": "shared ^1.1.0, peer react ^18.0.0",
+		"shared@1.2.0": "",
+		"shared@1.5.0": "",
+		"shared@2.1.0": "",
+		"react@17.0.2": "",
+	})
+	root := &pkgInstance{name: "root", version: "1.0.0", nested: map[string]*pkgInstance{}}
+	reqs := []pkgReq{
+		{name: "react", constraint: "^17.0.0"},
+		{name: "app-a", constraint: "^1.0.0"},
+		{name: "app-b", constraint: "^1.0.0"},
+		{name: "app-c", constraint: "^1.0.0"},
+	}
+	var stats installStats
+	instances, conflicts := resolveNpm(ix, root, reqs, &stats)
+
+	var got []string
+	for _, p := range instances {
+		got = append(got, p.location+"@"+p.version)
+	}
+	want := []string{
+		"node_modules/react@17.0.2",
+		"node_modules/app-a@1.0.0",
+		"node_modules/app-b@1.0.0",
+		"node_modules/app-c@1.0.0",
+		"node_modules/shared@1.5.0",
+		"node_modules/app-b/node_modules/shared@2.1.0",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("instances = %v, want %v", got, want)
+	}
+	// app-c 复用了顶层的 shared@1.5.0
+	if stats.deduped != 1 {
+		t.Errorf("deduped = %d, want 1", stats.deduped)
+	}
+	shared := instances[4]
+	if len(shared.parents) != 2 || shared.parents[0].name != "app-a" || shared.parents[1].name != "app-c" {
+		t.Errorf("shared@1.5.0 parents = %v", shared.parents)
+	}
+	// peer 依赖不满足时沿用已安装的 react@17 并记录冲突
+	if len(conflicts) != 1 || conflicts[0].from.name != "app-c" || conflicts[0].found.version != "17.0.2" {
+		t.Fatalf("conflicts = %+v, want app-c's peer react", conflicts)
+	}
+}
+
+func TestSelectMinimalVersions(t *testing.T) {
+	ix := newPackageIndex(map[string]string{
+		"example.com/a@v1.1.0": "example.com/c v1.2.0",
+		"example.com/b@v1.0.0": "example.com/c v1.3.0, example.com/d v0.1.0",
+		"example.com/c@v1.2.0": "example.com/d v0.2.0",
+		"example.com/c@v1.3.0": "",
+		"example.com/d@v0.1.0": "",
+		"example.com/d@v0.2.0": "",
+	})
+	reqs := []pkgReq{
+		{name: "example.com/a", constraint: "v1.1.0"},
+		{name: "example.com/b", constraint: "v1.0.0"},
+	}
+	bl := selectMinimalVersions(ix, "example.com/main", reqs)
+
+	want := map[string]string{
+		"example.com/a": "v1.1.0",
+		"example.com/b": "v1.0.0",
+		"example.com/c": "v1.3.0",
+		// c@v1.2.0 虽然没被选中，它的 require 仍然参与选择
+		"example.com/d": "v0.2.0",
+	}
+	if len(bl.selected) != len(want) {
+		t.Errorf("selected = %v, want %v", bl.selected, want)
+	}
+	for m, v := range want {
+		if bl.selected[m] != v {
+			t.Errorf("selected[%s] = %s, want %s", m, bl.selected[m], v)
+		}
+	}
+	if n := len(bl.requiredBy["example.com/c"]); n != 2 {
+		t.Errorf("example.com/c is required %d times, want 2", n)
+	}
+	if bl.edges != 6 {
+		t.Errorf("edges = %d, want 6", bl.edges)
+	}
+}
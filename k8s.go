@@ -0,0 +1,181 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// kubernetes 生成名字时使用的字符集（不含元音，避免拼出单词）
+const kubeNameAlphabet = "bcdfghjklmnpqrstvwxz2456789"
+
+// kubePod 一个 Pod 的显示状态
+type kubePod struct {
+	name     string
+	ready    string
+	status   string
+	restarts int
+	age      string
+}
+
+// kubeSuffix 生成 n 位 kubernetes 风格的随机后缀
+func kubeSuffix(n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = kubeNameAlphabet[rand.Intn(len(kubeNameAlphabet))]
+	}
+	return string(b)
+}
+
+// kubeNodeName 生成一个云主机风格的节点名
+func kubeNodeName() string {
+	return fmt.Sprintf("ip-10-0-%d-%d.ec2.internal", rand.Intn(64), rand.Intn(254)+1)
+}
+
+// printPodRow 按 kubectl get pods 的列宽打印一行，width 为名称列宽
+func printPodRow(width int, p kubePod) {
+	var status string
+	switch p.status {
+	case "Running":
+		status = green(fmt.Sprintf("%-18s", p.status))
+	case "Pending", "ContainerCreating", "Terminating":
+		status = yellow(fmt.Sprintf("%-18s", p.status))
+	default:
+		status = red(fmt.Sprintf("%-18s", p.status))
+	}
+	fmt.Printf("%-*s %-7s %s %-10d %s\n", width, p.name, p.ready, status, p.restarts, p.age)
+	time.Sleep(time.Duration(rand.Intn(200)+80) * time.Millisecond)
+}
+
+// kubeEvent 一条 kubectl describe 事件
+type kubeEvent struct {
+	kind, reason, age, from, message string
+}
+
+// printKubeEvents 按 kubectl describe 的格式打印事件表
+func printKubeEvents(events []kubeEvent) {
+	fmt.Println("Events:")
+	fmt.Printf("  %-8s %-18s %-5s %-26s %s\n", "Type", "Reason", "Age", "From", "Message")
+	fmt.Printf("  %-8s %-18s %-5s %-26s %s\n", "----", "------", "----", "----", "-------")
+	for _, e := range events {
+		kind := e.kind
+		if kind == "Warning" {
+			kind = yellow(fmt.Sprintf("%-8s", kind))
+		} else {
+			kind = fmt.Sprintf("%-8s", kind)
+		}
+		fmt.Printf("  %s %-18s %-5s %-26s %s\n", kind, e.reason, e.age, e.from, e.message)
+		time.Sleep(time.Duration(rand.Intn(150)+50) * time.Millisecond)
+	}
+}
+
+// runKubernetesRollout 模拟一次 Deployment 滚动发布，包括 Pod 状态变化和 HPA 扩容
+func runKubernetesRollout(config *SessionConfig) {
+	naming := config.naming
+	deploy := naming.serviceName(naming.randomService())
+	ns := naming.namespace
+	image := fmt.Sprintf("ghcr.io/%s/%s:v%s-%s", naming.org, deploy, naming.version, config.git.mainSHA)
+	replicas := rand.Intn(3) + 2
+	start := time.Now()
+
+	fmt.Println(blue("☸️  " + tr("k8s.title", deploy, ns)))
+	fmt.Printf("$ kubectl -n %s set image deployment/%s %s=%s\n", ns, deploy, deploy, image)
+	fmt.Printf("deployment.apps/%s image updated\n", deploy)
+
+	// 新旧 ReplicaSet 的 Pod
+	oldRS, newRS := kubeSuffix(10), kubeSuffix(10)
+	oldPods := make([]string, replicas)
+	newPods := make([]string, replicas)
+	for i := range oldPods {
+		oldPods[i] = fmt.Sprintf("%s-%s-%s", deploy, oldRS, kubeSuffix(5))
+		newPods[i] = fmt.Sprintf("%s-%s-%s", deploy, newRS, kubeSuffix(5))
+	}
+
+	fmt.Printf("$ kubectl -n %s get pods -l app=%s -w\n", ns, deploy)
+	width := len(oldPods[0]) + 2
+	fmt.Printf("%-*s %-7s %-18s %-10s %s\n", width, "NAME", "READY", "STATUS", "RESTARTS", "AGE")
+	oldAge := fmt.Sprintf("%dh", rand.Intn(70)+2)
+	for _, p := range oldPods {
+		printPodRow(width, kubePod{name: p, ready: "1/1", status: "Running", age: oldAge})
+	}
+	// 每次替换一个 Pod：maxSurge=1, maxUnavailable=0
+	crashed := -1
+	if rand.Float32() < 0.25 {
+		crashed = rand.Intn(replicas)
+	}
+	for i, p := range newPods {
+		printPodRow(width, kubePod{name: p, ready: "0/1", status: "Pending", age: "0s"})
+		printPodRow(width, kubePod{name: p, ready: "0/1", status: "ContainerCreating", age: "1s"})
+		restarts := 0
+		if i == crashed {
+			// 依赖尚未就绪导致的一次重启
+			printPodRow(width, kubePod{name: p, ready: "0/1", status: "Error", age: "4s"})
+			printPodRow(width, kubePod{name: p, ready: "0/1", status: "CrashLoopBackOff", age: "6s"})
+			restarts = 1
+		}
+		printPodRow(width, kubePod{name: p, ready: "0/1", status: "Running", restarts: restarts, age: fmt.Sprintf("%ds", 5+restarts*10)})
+		printPodRow(width, kubePod{name: p, ready: "1/1", status: "Running", restarts: restarts, age: fmt.Sprintf("%ds", 12+restarts*10)})
+		printPodRow(width, kubePod{name: oldPods[i], ready: "1/1", status: "Terminating", age: oldAge})
+	}
+
+	fmt.Printf("$ kubectl -n %s rollout status deployment/%s\n", ns, deploy)
+	for i := 1; i < replicas; i++ {
+		fmt.Printf("Waiting for deployment %q rollout to finish: %d of %d updated replicas are available...\n", deploy, i, replicas)
+		time.Sleep(time.Duration(rand.Intn(300)+150) * time.Millisecond)
+	}
+	fmt.Printf("deployment %q successfully rolled out\n", deploy)
+
+	// 新 Pod 的事件流
+	pod := newPods[rand.Intn(len(newPods))]
+	if crashed >= 0 {
+		pod = newPods[crashed]
+	}
+	fmt.Printf("$ kubectl -n %s describe pod %s\n", ns, pod)
+	pullSeconds := rand.Float64()*8 + 1
+	events := []kubeEvent{
+		{"Normal", "Scheduled", "38s", "default-scheduler", fmt.Sprintf("Successfully assigned %s/%s to %s", ns, pod, kubeNodeName())},
+		{"Normal", "Pulling", "37s", "kubelet", fmt.Sprintf("Pulling image %q", image)},
+		{"Normal", "Pulled", "30s", "kubelet", fmt.Sprintf("Successfully pulled image %q in %.3fs (%.3fs including waiting)", image, pullSeconds, pullSeconds+0.2)},
+		{"Normal", "Created", "30s", "kubelet", "Created container " + deploy},
+		{"Normal", "Started", "29s", "kubelet", "Started container " + deploy},
+	}
+	if crashed >= 0 {
+		events = append(events,
+			kubeEvent{"Warning", "BackOff", "25s", "kubelet", fmt.Sprintf("Back-off restarting failed container %s in pod %s", deploy, pod)})
+	}
+	if crashed >= 0 || rand.Float32() < 0.4 {
+		events = append(events,
+			kubeEvent{"Warning", "Unhealthy", "22s", "kubelet", "Readiness probe failed: HTTP probe failed with statuscode: 503"})
+	}
+	printKubeEvents(events)
+
+	// HPA 扩容，对应系统事件中的自动扩容
+	if rand.Float32() < 0.5 {
+		target := rand.Intn(20) + 60
+		current := target + rand.Intn(35) + 5
+		scaled := replicas + rand.Intn(3) + 1
+		fmt.Printf("$ kubectl -n %s get hpa %s\n", ns, deploy)
+		ref := "Deployment/" + deploy
+		fmt.Printf("%-*s %-*s %-18s %-8s %-8s %-9s %s\n", len(deploy)+2, "NAME", len(ref)+2, "REFERENCE", "TARGETS", "MINPODS", "MAXPODS", "REPLICAS", "AGE")
+		fmt.Printf("%-*s %-*s %-18s %-8d %-8d %-9d %s\n", len(deploy)+2, deploy, len(ref)+2, ref,
+			fmt.Sprintf("cpu: %d%%/%d%%", current, target), replicas, replicas*4, replicas, fmt.Sprintf("%dd", rand.Intn(60)+3))
+		fmt.Printf("⚖️  %s\n", yellow(tr("event.autoscale", deploy, ns)))
+		fmt.Printf("$ kubectl -n %s describe hpa %s\n", ns, deploy)
+		printKubeEvents([]kubeEvent{
+			{"Normal", "SuccessfulRescale", "3s", "horizontal-pod-autoscaler",
+				fmt.Sprintf("New size: %d; reason: cpu resource utilization (percentage of request) above target", scaled)},
+		})
+		fmt.Printf("$ kubectl -n %s get pods -l app=%s --watch-only\n", ns, deploy)
+		for i := replicas; i < scaled; i++ {
+			name := fmt.Sprintf("%s-%s-%s", deploy, newRS, kubeSuffix(5))
+			printPodRow(width, kubePod{name: name, ready: "0/1", status: "Pending", age: "0s"})
+			printPodRow(width, kubePod{name: name, ready: "1/1", status: "Running", age: "9s"})
+		}
+		replicas = scaled
+	}
+
+	fmt.Printf("✅ %s\n", tr("k8s.summary", deploy, replicas, replicas, formatFloat(time.Since(start).Seconds(), 1)))
+	if rand.Float32() < jargonDensity(config.jargonLevel) {
+		fmt.Printf("🧠 %s\n", tr("jargon.insight", generateJargon(config.devType, config.jargonLevel)))
+	}
+}
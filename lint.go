@@ -0,0 +1,362 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"path"
+	"sort"
+	"strings"
+)
+
+// LintRule 静态检查规则
+type LintRule struct {
+	id       string
+	linter   string
+	severity string // error / warning / info
+	category string // 对应 generateCodeIssue 的问题类别
+	message  string // %s 会被替换为标识符
+	fix      string
+	devTypes []DevelopmentType // 为空表示适用于所有开发类型
+}
+
+// LintFinding 一条检查结果
+type LintFinding struct {
+	path     string
+	language string
+	line     int
+	col      int
+	rule     *LintRule
+	ident    string
+	message  string
+}
+
+// 问题类别
+const (
+	issueMemoryLeak   = "Potential memory leak"
+	issueUncaught     = "Uncaught exception"
+	issueResource     = "Resource not released"
+	issueInefficient  = "Inefficient algorithm"
+	issueSecurity     = "Security vulnerability"
+	issueMaintainable = "Maintainability"
+)
+
+// 问题类别对应的消息目录键
+var issueKeys = map[string]string{
+	issueMemoryLeak:   "analysis.issue.memoryLeak",
+	issueUncaught:     "analysis.issue.uncaught",
+	issueResource:     "analysis.issue.resource",
+	issueInefficient:  "analysis.issue.inefficient",
+	issueSecurity:     "analysis.issue.security",
+	issueMaintainable: "analysis.issue.maintainable",
+}
+
+// issueLabel 返回问题类别在当前语言下的名称
+func issueLabel(category string) string {
+	return tr(issueKeys[category])
+}
+
+// 各语言的规则集
+var lintRules = map[string][]LintRule{
+	"go": {
+		{id: "SA4006", linter: "staticcheck", severity: "warning", category: issueMaintainable,
+			message: "this value of `%s` is never used", fix: "remove the assignment or use the value"},
+		{id: "SA5011", linter: "staticcheck", severity: "error", category: issueUncaught,
+			message: "possible nil pointer dereference of `%s`", fix: "check `%s != nil` before dereferencing"},
+		{id: "SA1019", linter: "staticcheck", severity: "warning", category: issueMaintainable,
+			message: "`ioutil.ReadAll` has been deprecated since Go 1.16", fix: "use io.ReadAll instead"},
+		{id: "errcheck", linter: "errcheck", severity: "error", category: issueUncaught,
+			message: "Error return value of `%s.Close` is not checked", fix: "handle the error returned by Close"},
+		{id: "lostcancel", linter: "govet", severity: "error", category: issueMemoryLeak,
+			message: "the cancel function returned by context.WithTimeout should be called, not discarded, to avoid a context leak", fix: "defer cancel() right after creating the context"},
+		{id: "bodyclose", linter: "bodyclose", severity: "warning", category: issueResource,
+			message: "response body must be closed", fix: "add defer resp.Body.Close() after the error check",
+			devTypes: []DevelopmentType{Backend, Fullstack, DevOps}},
+		{id: "prealloc", linter: "prealloc", severity: "info", category: issueInefficient,
+			message: "Consider preallocating `%s`", fix: "make(%s, 0, len(src))"},
+		{id: "G101", linter: "gosec", severity: "error", category: issueSecurity,
+			message: "Potential hardcoded credentials", fix: "load the credential from the secret store",
+			devTypes: []DevelopmentType{Security, Backend, DevOps}},
+		{id: "G304", linter: "gosec", severity: "warning", category: issueSecurity,
+			message: "Potential file inclusion via variable", fix: "clean the path with filepath.Clean and restrict it to a base directory",
+			devTypes: []DevelopmentType{Security, Backend, SystemsProgramming}},
+		{id: "ST1003", linter: "stylecheck", severity: "info", category: issueMaintainable,
+			message: "should not use underscores in Go names; var `%s_id` should be `%sID`", fix: "rename to follow Go naming conventions"},
+	},
+	"rust": {
+		{id: "clippy::needless_collect", linter: "clippy", severity: "warning", category: issueInefficient,
+			message: "avoid using `collect()` when not needed", fix: "use the iterator directly: `.count()`"},
+		{id: "clippy::redundant_clone", linter: "clippy", severity: "warning", category: issueInefficient,
+			message: "redundant clone of `%s`", fix: "remove this `.clone()`"},
+		{id: "clippy::unwrap_used", linter: "clippy", severity: "warning", category: issueUncaught,
+			message: "used `unwrap()` on a `Result` value", fix: "propagate the error with `?`"},
+		{id: "clippy::large_enum_variant", linter: "clippy", severity: "warning", category: issueInefficient,
+			message: "large size difference between variants", fix: "consider boxing the large fields to reduce the total size of the enum",
+			devTypes: []DevelopmentType{SystemsProgramming, Blockchain, GameDevelopment}},
+		{id: "clippy::mem_forget", linter: "clippy", severity: "error", category: issueMemoryLeak,
+			message: "usage of `mem::forget` on `Drop` type `%s`", fix: "let the value drop or use `ManuallyDrop`"},
+		{id: "unused_variables", linter: "rustc", severity: "warning", category: issueMaintainable,
+			message: "unused variable: `%s`", fix: "if this is intentional, prefix it with an underscore: `_%s`"},
+		{id: "clippy::cast_possible_truncation", linter: "clippy", severity: "warning", category: issueSecurity,
+			message: "casting `u64` to `u32` may truncate the value", fix: "use `u32::try_from(%s)?`",
+			devTypes: []DevelopmentType{Blockchain, Security, SystemsProgramming}},
+		{id: "clippy::await_holding_lock", linter: "clippy", severity: "error", category: issueResource,
+			message: "this `MutexGuard` is held across an `await` point", fix: "drop the guard before awaiting"},
+	},
+	"typescript": {
+		{id: "no-unused-vars", linter: "eslint", severity: "error", category: issueMaintainable,
+			message: "'%s' is defined but never used.", fix: "remove the unused binding"},
+		{id: "@typescript-eslint/no-explicit-any", linter: "eslint", severity: "warning", category: issueMaintainable,
+			message: "Unexpected any. Specify a different type.", fix: "replace `any` with `unknown` or a concrete type"},
+		{id: "@typescript-eslint/no-floating-promises", linter: "eslint", severity: "error", category: issueUncaught,
+			message: "Promises must be awaited, end with a call to .catch, or end with a call to .then with a rejection handler.", fix: "await the promise or add .catch()"},
+		{id: "react-hooks/exhaustive-deps", linter: "eslint", severity: "warning", category: issueMemoryLeak,
+			message: "React Hook useEffect has a missing dependency: '%s'. Either include it or remove the dependency array.", fix: "add '%s' to the dependency array and return a cleanup function",
+			devTypes: []DevelopmentType{Frontend, Fullstack}},
+		{id: "jsx-a11y/alt-text", linter: "eslint", severity: "warning", category: issueMaintainable,
+			message: "img elements must have an alt prop, either with meaningful text, or an empty string for decorative images.", fix: "add an alt attribute",
+			devTypes: []DevelopmentType{Frontend, Fullstack}},
+		{id: "security/detect-object-injection", linter: "eslint", severity: "warning", category: issueSecurity,
+			message: "Generic Object Injection Sink", fix: "validate the key against an allow-list",
+			devTypes: []DevelopmentType{Security, Backend, Fullstack}},
+		{id: "sonarjs/cognitive-complexity", linter: "eslint", severity: "warning", category: issueInefficient,
+			message: "Refactor this function to reduce its Cognitive Complexity from 23 to the 15 allowed.", fix: "extract nested branches into helper functions"},
+	},
+	"javascript": {
+		{id: "no-unused-vars", linter: "eslint", severity: "error", category: issueMaintainable,
+			message: "'%s' is defined but never used.", fix: "remove the unused binding"},
+		{id: "no-undef", linter: "eslint", severity: "error", category: issueUncaught,
+			message: "'%s' is not defined.", fix: "import or declare '%s'"},
+		{id: "prefer-const", linter: "eslint", severity: "warning", category: issueMaintainable,
+			message: "'%s' is never reassigned. Use 'const' instead.", fix: "replace let with const"},
+		{id: "no-await-in-loop", linter: "eslint", severity: "warning", category: issueInefficient,
+			message: "Unexpected `await` inside a loop.", fix: "collect the promises and use Promise.all"},
+		{id: "react-hooks/exhaustive-deps", linter: "eslint", severity: "warning", category: issueMemoryLeak,
+			message: "React Hook useEffect has a missing dependency: '%s'. Either include it or remove the dependency array.", fix: "add '%s' to the dependency array and return a cleanup function",
+			devTypes: []DevelopmentType{Frontend, Fullstack}},
+		{id: "no-eval", linter: "eslint", severity: "error", category: issueSecurity,
+			message: "eval can be harmful.", fix: "parse the input with JSON.parse instead"},
+	},
+	"vue": {
+		{id: "vue/no-unused-components", linter: "eslint", severity: "error", category: issueMaintainable,
+			message: "The \"%s\" component has been registered but not used.", fix: "remove the component registration"},
+		{id: "vue/require-v-for-key", linter: "eslint", severity: "error", category: issueInefficient,
+			message: "Elements in iteration expect to have 'v-bind:key' directives.", fix: "add :key=\"item.id\""},
+		{id: "vue/no-mutating-props", linter: "eslint", severity: "error", category: issueMaintainable,
+			message: "Unexpected mutation of \"%s\" prop.", fix: "emit an update event instead"},
+		{id: "vue/no-v-html", linter: "eslint", severity: "warning", category: issueSecurity,
+			message: "'v-html' directive can lead to XSS attack.", fix: "render sanitized text instead of raw HTML"},
+		{id: "no-unused-vars", linter: "eslint", severity: "error", category: issueMaintainable,
+			message: "'%s' is defined but never used.", fix: "remove the unused binding"},
+	},
+	"python": {
+		{id: "F401", linter: "ruff", severity: "warning", category: issueMaintainable,
+			message: "`os` imported but unused", fix: "Remove unused import: `os`"},
+		{id: "F841", linter: "ruff", severity: "warning", category: issueMaintainable,
+			message: "Local variable `%s` is assigned to but never used", fix: "Remove assignment to unused variable `%s`"},
+		{id: "B006", linter: "ruff", severity: "warning", category: issueUncaught,
+			message: "Do not use mutable data structures for argument defaults", fix: "Replace with `None`; initialize within function"},
+		{id: "SIM115", linter: "ruff", severity: "warning", category: issueResource,
+			message: "Use a context manager for opening files", fix: "wrap the call in a `with` statement"},
+		{id: "PERF401", linter: "ruff", severity: "info", category: issueInefficient,
+			message: "Use a list comprehension to create a transformed list", fix: "replace the for loop with a list comprehension"},
+		{id: "S301", linter: "ruff", severity: "error", category: issueSecurity,
+			message: "`pickle` and modules that wrap it can be unsafe when used to deserialize untrusted data", fix: "load models with safetensors or a signed format",
+			devTypes: []DevelopmentType{DataScience, MachineLearning, Security}},
+		{id: "PD002", linter: "ruff", severity: "warning", category: issueInefficient,
+			message: "`inplace=True` should be avoided; it has inconsistent behavior", fix: "assign the result back to the DataFrame",
+			devTypes: []DevelopmentType{DataScience, MachineLearning}},
+		{id: "NPY002", linter: "ruff", severity: "info", category: issueMaintainable,
+			message: "Replace legacy `np.random.seed` call with `np.random.Generator`", fix: "use `rng = np.random.default_rng(seed)`",
+			devTypes: []DevelopmentType{DataScience, MachineLearning}},
+	},
+	"java": {
+		{id: "UnusedImports", linter: "checkstyle", severity: "warning", category: issueMaintainable,
+			message: "Unused import - java.util.List.", fix: "remove the import"},
+		{id: "CloseResource", linter: "pmd", severity: "error", category: issueResource,
+			message: "Ensure that resources like this '%s' object are closed after use", fix: "use try-with-resources"},
+		{id: "NP_NULL_ON_SOME_PATH", linter: "spotbugs", severity: "error", category: issueUncaught,
+			message: "Possible null pointer dereference of %s", fix: "guard with Objects.requireNonNull"},
+		{id: "AvoidInstantiatingObjectsInLoops", linter: "pmd", severity: "info", category: issueInefficient,
+			message: "Avoid instantiating new objects inside loops", fix: "hoist the allocation out of the loop"},
+		{id: "SQL_INJECTION_JDBC", linter: "spotbugs", severity: "error", category: issueSecurity,
+			message: "This use of java/sql/Statement.executeQuery can be vulnerable to SQL injection", fix: "use a PreparedStatement with bind parameters"},
+		{id: "ThreadLocalLeak", linter: "sonar", severity: "warning", category: issueMemoryLeak,
+			message: "Call \"%s.remove()\" on this ThreadLocal", fix: "remove the value in a finally block"},
+	},
+	"cpp": {
+		{id: "modernize-use-auto", linter: "clang-tidy", severity: "warning", category: issueMaintainable,
+			message: "use auto when initializing with new to avoid duplicating the type name", fix: "replace the type with `auto`"},
+		{id: "performance-unnecessary-value-param", linter: "clang-tidy", severity: "warning", category: issueInefficient,
+			message: "the parameter '%s' is copied for each invocation but only used as a const reference", fix: "consider making it a const reference"},
+		{id: "cppcoreguidelines-owning-memory", linter: "clang-tidy", severity: "error", category: issueMemoryLeak,
+			message: "initializing non-owner '%s' with a newly created 'gsl::owner<>'", fix: "wrap the allocation in std::unique_ptr"},
+		{id: "bugprone-use-after-move", linter: "clang-tidy", severity: "error", category: issueUncaught,
+			message: "'%s' used after it was moved", fix: "do not access the object after std::move"},
+		{id: "performance-inefficient-vector-operation", linter: "clang-tidy", severity: "warning", category: issueInefficient,
+			message: "'push_back' is called inside a loop; consider pre-allocating the container capacity before the loop", fix: "call reserve() before the loop"},
+		{id: "readability-function-cognitive-complexity", linter: "clang-tidy", severity: "info", category: issueMaintainable,
+			message: "function 'Tick' has cognitive complexity of 41 (threshold 25)", fix: "split the per-frame update into smaller systems",
+			devTypes: []DevelopmentType{GameDevelopment}},
+	},
+	"solidity": {
+		{id: "reentrancy", linter: "solhint", severity: "error", category: issueSecurity,
+			message: "Possible reentrancy vulnerabilities. Avoid state changes after transfer.", fix: "apply checks-effects-interactions or a ReentrancyGuard"},
+		{id: "avoid-tx-origin", linter: "solhint", severity: "error", category: issueSecurity,
+			message: "Avoid to use tx.origin", fix: "use msg.sender for authorization"},
+		{id: "gas-custom-errors", linter: "solhint", severity: "warning", category: issueInefficient,
+			message: "Use Custom Errors instead of require statements", fix: "declare `error Unauthorized();` and revert with it"},
+		{id: "no-unused-vars", linter: "solhint", severity: "warning", category: issueMaintainable,
+			message: "Variable \"%s\" is unused", fix: "remove the variable"},
+	},
+}
+
+// 通用规则，在不认识文件语言时使用
+var genericLintRules = []LintRule{
+	{id: "max-line-length", linter: "editorconfig", severity: "info", category: issueMaintainable,
+		message: "Line exceeds 120 characters", fix: "wrap the line"},
+	{id: "todo-comment", linter: "editorconfig", severity: "info", category: issueMaintainable,
+		message: "Unresolved TODO comment", fix: "open a ticket and reference it"},
+}
+
+// 用于填充消息的标识符
+var lintIdentifiers = []string{"err", "ctx", "resp", "cfg", "buf", "items", "user", "conn", "handler", "result"}
+
+// languageForFile 根据扩展名判断文件语言
+func languageForFile(filePath string) string {
+	switch path.Ext(filePath) {
+	case ".go":
+		return "go"
+	case ".rs":
+		return "rust"
+	case ".ts", ".tsx":
+		return "typescript"
+	case ".js", ".jsx", ".mjs":
+		return "javascript"
+	case ".vue":
+		return "vue"
+	case ".py":
+		return "python"
+	case ".java", ".kt":
+		return "java"
+	case ".cpp", ".cc", ".h", ".hpp":
+		return "cpp"
+	case ".sol":
+		return "solidity"
+	}
+	return "generic"
+}
+
+// rulesFor 返回适用于语言和开发类型的规则
+func rulesFor(language string, devType DevelopmentType) []*LintRule {
+	rules, ok := lintRules[language]
+	if !ok {
+		rules = genericLintRules
+	}
+	var result []*LintRule
+	for i := range rules {
+		rule := &rules[i]
+		if len(rule.devTypes) == 0 {
+			result = append(result, rule)
+			continue
+		}
+		for _, d := range rule.devTypes {
+			if d == devType {
+				result = append(result, rule)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// generateCodeIssue 为文件生成一条符合其语言和开发类型的检查结果，行号不超过文件长度
+func generateCodeIssue(devType DevelopmentType, file RepoFile) LintFinding {
+	language := languageForFile(file.path)
+	rules := rulesFor(language, devType)
+	rule := rules[rand.Intn(len(rules))]
+	ident := lintIdentifiers[rand.Intn(len(lintIdentifiers))]
+
+	return LintFinding{
+		path:     file.path,
+		language: language,
+		line:     rand.Intn(max(file.lines-12, 1)) + min(12, file.lines),
+		col:      rand.Intn(30) + 2,
+		rule:     rule,
+		ident:    ident,
+		message:  fillIdentifier(rule.message, ident),
+	}
+}
+
+// generateCodeIssues 为文件生成 n 条规则互不相同的检查结果，按行号排序
+func generateCodeIssues(devType DevelopmentType, file RepoFile, n int) []LintFinding {
+	var findings []LintFinding
+	seen := map[string]bool{}
+	for attempts := 0; len(findings) < n && attempts < n*4; attempts++ {
+		finding := generateCodeIssue(devType, file)
+		if seen[finding.rule.id] {
+			continue
+		}
+		seen[finding.rule.id] = true
+		findings = append(findings, finding)
+	}
+	sort.Slice(findings, func(i, j int) bool { return findings[i].line < findings[j].line })
+	return findings
+}
+
+// fillIdentifier 将消息中的 %s 全部替换为同一个标识符
+func fillIdentifier(msg, ident string) string {
+	return strings.ReplaceAll(msg, "%s", ident)
+}
+
+// format 按对应工具的输出格式格式化检查结果
+func (f LintFinding) format() string {
+	loc := fmt.Sprintf("%s:%d:%d", f.path, f.line, f.col)
+	switch f.rule.linter {
+	case "staticcheck", "errcheck", "govet", "bodyclose", "prealloc", "gosec", "stylecheck":
+		// golangci-lint
+		return fmt.Sprintf("%s: %s: %s (%s)", loc, f.rule.id, f.message, f.rule.linter)
+	case "clippy", "rustc":
+		return fmt.Sprintf("%s: %s: %s [%s]", loc, f.rule.severity, f.message, f.rule.id)
+	case "eslint":
+		// eslint --format unix
+		return fmt.Sprintf("%s: %s [%s/%s]", loc, f.message, exportedName(f.rule.severity), f.rule.id)
+	case "ruff":
+		return fmt.Sprintf("%s: %s %s", loc, f.rule.id, f.message)
+	case "solhint":
+		return fmt.Sprintf("%s: %s [%s/%s]", loc, f.message, exportedName(f.rule.severity), f.rule.id)
+	case "checkstyle", "pmd", "spotbugs", "sonar":
+		return fmt.Sprintf("[%s] %s: %s [%s]", strings.ToUpper(f.rule.severity), loc, f.message, f.rule.id)
+	}
+	return fmt.Sprintf("%s: %s: %s [%s]", loc, f.rule.severity, f.message, f.rule.id)
+}
+
+// fix 返回建议的修复方式
+func (f LintFinding) fix() string {
+	return fillIdentifier(f.rule.fix, f.ident)
+}
+
+// severityLabel 返回带颜色的严重级别标签
+func severityLabel(severity string) string {
+	switch severity {
+	case "error":
+		return red("error  ")
+	case "warning":
+		return yellow("warning")
+	}
+	return blue("info   ")
+}
+
+// generateComplexityMetric 生成随文件变化的复杂度指标
+func generateComplexityMetric() string {
+	switch rand.Intn(5) {
+	case 0:
+		return fmt.Sprintf("Cyclomatic complexity: %d", rand.Intn(22)+3)
+	case 1:
+		return fmt.Sprintf("Cognitive complexity: %d", rand.Intn(18)+2)
+	case 2:
+		return fmt.Sprintf("Maintainability index: %d", rand.Intn(40)+55)
+	case 3:
+		return fmt.Sprintf("Code coverage: %d%%", rand.Intn(35)+62)
+	}
+	return fmt.Sprintf("Technical debt ratio: %.1f%%", rand.Float64()*9+0.5)
+}
@@ -0,0 +1,466 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 压测工具
+var loadTools = []string{"wrk", "k6", "vegeta"}
+
+// loadSampleBudget 一次压测最多模拟的样本数，超出部分按权重放大计数
+const loadSampleBudget = 20000
+
+// loadBuckets 延迟分布的桶边界
+var loadBuckets = []time.Duration{
+	0, 10 * time.Millisecond, 25 * time.Millisecond, 50 * time.Millisecond, 100 * time.Millisecond,
+	250 * time.Millisecond, 500 * time.Millisecond, time.Second,
+}
+
+// loadSample 一次压测请求的结果
+type loadSample struct {
+	at      float64 // 压测开始后的秒数
+	latency time.Duration
+	status  int
+}
+
+// loadModel 被测服务的延迟模型：对数正态分布加上少量长尾和错误
+type loadModel struct {
+	median  float64 // ms
+	sigma   float64
+	tail    float64 // 长尾请求比例
+	errRate float64
+}
+
+// sample 生成一次请求的延迟和状态码
+func (m loadModel) sample() (time.Duration, int) {
+	ms := m.median * math.Exp(m.sigma*rand.NormFloat64())
+	if rand.Float64() < m.tail {
+		ms *= 4 + rand.Float64()*8
+	}
+	status := 200
+	if rand.Float64() < m.errRate {
+		status = []int{502, 503, 503, 504}[rand.Intn(4)]
+		if status == 504 {
+			ms = 1000 + rand.Float64()*40
+		}
+	}
+	return time.Duration(ms * float64(time.Millisecond)), status
+}
+
+// closedLoop 模拟固定数量的连接各自串行发请求（wrk 和 k6 的方式）。
+// 只模拟样本预算能容纳的连接数，同时返回每个样本代表的请求数
+func (m loadModel) closedLoop(conns int, duration float64) ([]loadSample, float64) {
+	perConn := duration * 1000 / (m.median * math.Exp(m.sigma*m.sigma/2))
+	simulated := min(conns, max(int(loadSampleBudget/perConn), 1))
+	var samples []loadSample
+	for c := 0; c < simulated; c++ {
+		for at := rand.Float64() * 0.01; ; {
+			latency, status := m.sample()
+			at += latency.Seconds()
+			if at > duration {
+				break
+			}
+			samples = append(samples, loadSample{at, latency, status})
+		}
+	}
+	sort.Slice(samples, func(i, j int) bool { return samples[i].at < samples[j].at })
+	return samples, float64(conns) / float64(simulated)
+}
+
+// openLoop 模拟按固定速率发请求，不等待响应（vegeta 的方式），样本在时间上均匀抽取
+func (m loadModel) openLoop(rate int, duration float64) ([]loadSample, float64) {
+	total := float64(rate) * duration
+	samples := make([]loadSample, int(math.Min(total, loadSampleBudget)))
+	for i := range samples {
+		latency, status := m.sample()
+		samples[i] = loadSample{float64(i) * duration / float64(len(samples)), latency, status}
+	}
+	return samples, total / float64(len(samples))
+}
+
+// loadStats 从样本中算出的统计量，计数按样本权重放大，分布只看样本本身
+type loadStats struct {
+	sorted          []time.Duration
+	weight          float64 // 每个样本代表的请求数
+	mean, stdev     time.Duration
+	withinStdev     float64 // 落在均值一个标准差内的比例
+	requests        int
+	errors          int
+	statuses        map[int]int
+	elapsed         float64
+	bytes, reqBytes int
+}
+
+// newLoadStats 统计样本，weight 是每个样本代表的请求数
+func newLoadStats(samples []loadSample, weight, elapsed float64, respBytes int) loadStats {
+	s := loadStats{statuses: map[int]int{}, weight: weight, elapsed: elapsed}
+	var sum, sumSq, bytes float64
+	counts := map[int]int{}
+	for _, x := range samples {
+		s.sorted = append(s.sorted, x.latency)
+		counts[x.status]++
+		if x.status >= 400 {
+			bytes += 180
+		} else {
+			bytes += float64(respBytes + rand.Intn(64))
+		}
+		ms := float64(x.latency)
+		sum += ms
+		sumSq += ms * ms
+	}
+	for code, n := range counts {
+		scaled := int(math.Round(float64(n) * weight))
+		s.statuses[code] = scaled
+		s.requests += scaled
+		if code >= 400 {
+			s.errors += scaled
+		}
+	}
+	s.bytes = int(bytes * weight)
+	sort.Slice(s.sorted, func(i, j int) bool { return s.sorted[i] < s.sorted[j] })
+	n := float64(len(samples))
+	mean := sum / n
+	stdev := math.Sqrt(math.Max(sumSq/n-mean*mean, 0))
+	s.mean, s.stdev = time.Duration(mean), time.Duration(stdev)
+	within := 0
+	for _, d := range s.sorted {
+		if math.Abs(float64(d)-mean) <= stdev {
+			within++
+		}
+	}
+	s.withinStdev = float64(within) / n * 100
+	s.reqBytes = s.requests * (rand.Intn(40) + 150)
+	return s
+}
+
+// percentile 按最近秩法取分位数
+func (s loadStats) percentile(p float64) time.Duration {
+	i := int(math.Ceil(p/100*float64(len(s.sorted)))) - 1
+	return s.sorted[min(max(i, 0), len(s.sorted)-1)]
+}
+
+// rps 平均每秒请求数
+func (s loadStats) rps() float64 {
+	return float64(s.requests) / s.elapsed
+}
+
+// errorRate 失败请求所占比例
+func (s loadStats) errorRate() float64 {
+	return float64(s.errors) / float64(s.requests)
+}
+
+// wrkDuration 按 wrk 的格式输出时长，如 812.00us、38.21ms、1.21s
+func wrkDuration(d time.Duration, micro string) string {
+	switch {
+	case d < time.Millisecond:
+		return fmt.Sprintf("%.2f%s", float64(d)/float64(time.Microsecond), micro)
+	case d < time.Second:
+		return fmt.Sprintf("%.2fms", float64(d)/float64(time.Millisecond))
+	}
+	return fmt.Sprintf("%.2fs", d.Seconds())
+}
+
+// wrkBytes 按 wrk 的格式输出字节数（1024 进制）
+func wrkBytes(b float64) string {
+	for _, unit := range []string{"B", "KB", "MB"} {
+		if b < 1024 {
+			return fmt.Sprintf("%.2f%s", b, unit)
+		}
+		b /= 1024
+	}
+	return fmt.Sprintf("%.2fGB", b)
+}
+
+// k6Bytes 按 k6 的格式输出字节数（1000 进制）
+func k6Bytes(b float64) string {
+	units := []string{"B", "kB", "MB", "GB"}
+	i := 0
+	for b >= 1000 && i < len(units)-1 {
+		b /= 1000
+		i++
+	}
+	if b < 10 {
+		return fmt.Sprintf("%.1f %s", b, units[i])
+	}
+	return fmt.Sprintf("%.0f %s", b, units[i])
+}
+
+// printWrkReport 按 wrk --latency 的格式输出结果
+func printWrkReport(s loadStats, samples []loadSample, threads, conns int) {
+	// 每个线程每秒完成的请求数
+	perSecond := map[[2]int]int{}
+	for i, x := range samples {
+		perSecond[[2]int{i % threads, int(x.at)}]++
+	}
+	var rates []float64
+	var sum, sumSq, top float64
+	for _, n := range perSecond {
+		r := float64(n) * s.weight
+		rates = append(rates, r)
+		sum += r
+		sumSq += r * r
+		top = math.Max(top, r)
+	}
+	mean := sum / float64(len(rates))
+	stdev := math.Sqrt(math.Max(sumSq/float64(len(rates))-mean*mean, 0))
+	within := 0
+	for _, r := range rates {
+		if math.Abs(r-mean) <= stdev {
+			within++
+		}
+	}
+
+	fmt.Printf("  %d threads and %d connections\n", threads, conns)
+	fmt.Println("  Thread Stats   Avg      Stdev     Max   +/- Stdev")
+	fmt.Printf("    Latency   %8s  %8s  %8s  %6.2f%%\n", wrkDuration(s.mean, "us"), wrkDuration(s.stdev, "us"), wrkDuration(s.sorted[len(s.sorted)-1], "us"), s.withinStdev)
+	fmt.Printf("    Req/Sec   %8.2f  %8.2f  %8.2f  %6.2f%%\n", mean, stdev, top, float64(within)/float64(len(rates))*100)
+	fmt.Println("  Latency Distribution")
+	for _, p := range []float64{50, 75, 90, 99} {
+		fmt.Printf("  %3.0f%%  %8s\n", p, wrkDuration(s.percentile(p), "us"))
+	}
+	fmt.Printf("  %d requests in %.2fs, %s read\n", s.requests, s.elapsed, wrkBytes(float64(s.bytes)))
+	if s.errors > 0 {
+		fmt.Println(red(fmt.Sprintf("  Non-2xx or 3xx responses: %d", s.errors)))
+	}
+	fmt.Printf("Requests/sec: %10.2f\n", s.rps())
+	fmt.Printf("Transfer/sec: %10s\n", wrkBytes(float64(s.bytes)/s.elapsed))
+}
+
+// k6Metric 输出一行 k6 指标，名称用点号补齐
+func k6Metric(mark, name, value string) {
+	prefix := "     "
+	if mark != "" {
+		prefix = "   " + mark + " "
+	}
+	fmt.Printf("%s%s: %s\n", prefix, name+strings.Repeat(".", max(31-len(name), 3)), value)
+}
+
+// printK6Report 按 k6 的结束摘要格式输出结果
+func printK6Report(s loadStats, vus int, durationOK, failedOK bool) {
+	mark := func(ok bool) string {
+		if ok {
+			return green("✓")
+		}
+		return red("✗")
+	}
+	durations := func(d loadStats) string {
+		return fmt.Sprintf("avg=%-8s min=%-8s med=%-8s max=%-8s p(90)=%-8s p(95)=%s",
+			wrkDuration(d.mean, "µs"), wrkDuration(d.sorted[0], "µs"), wrkDuration(d.percentile(50), "µs"),
+			wrkDuration(d.sorted[len(d.sorted)-1], "µs"), wrkDuration(d.percentile(90), "µs"), wrkDuration(d.percentile(95), "µs"))
+	}
+	ok := s.requests - s.errors
+	fmt.Println()
+	fmt.Printf("     %s status is 200\n", mark(s.errors == 0))
+	if s.errors > 0 {
+		fmt.Printf("      ↳  %.0f%% — ✓ %d / ✗ %d\n", math.Floor(float64(ok)/float64(s.requests)*100), ok, s.errors)
+	}
+	fmt.Println()
+	k6Metric("", "checks", fmt.Sprintf("%.2f%% ✓ %-10d ✗ %d", float64(ok)/float64(s.requests)*100, ok, s.errors))
+	k6Metric("", "data_received", fmt.Sprintf("%-6s %s/s", k6Bytes(float64(s.bytes)), k6Bytes(float64(s.bytes)/s.elapsed)))
+	k6Metric("", "data_sent", fmt.Sprintf("%-6s %s/s", k6Bytes(float64(s.reqBytes)), k6Bytes(float64(s.reqBytes)/s.elapsed)))
+	k6Metric(mark(durationOK), "http_req_duration", durations(s))
+	k6Metric(mark(failedOK), "http_req_failed", fmt.Sprintf("%.2f%%  ✓ %-10d ✗ %d", s.errorRate()*100, s.errors, ok))
+	k6Metric("", "http_reqs", fmt.Sprintf("%-6d %.6f/s", s.requests, s.rps()))
+	k6Metric("", "iterations", fmt.Sprintf("%-6d %.6f/s", s.requests, s.rps()))
+	k6Metric("", "vus", fmt.Sprintf("%-6d min=%-8d max=%d", vus, vus, vus))
+	k6Metric("", "vus_max", fmt.Sprintf("%-6d min=%-8d max=%d", vus, vus, vus))
+}
+
+// vegetaDuration 按 vegeta 的格式输出时长（time.Duration 精确到微秒）
+func vegetaDuration(d time.Duration) string {
+	return d.Round(time.Microsecond).String()
+}
+
+// printVegetaReport 按 vegeta report 的文本格式输出结果
+func printVegetaReport(s loadStats, rate int, samples []loadSample) {
+	last := samples[len(samples)-1]
+	attack := time.Duration(last.at * float64(time.Second))
+	wait := last.latency
+	success := float64(s.requests-s.errors) / float64(s.requests)
+	fmt.Printf("Requests      [total, rate, throughput]         %d, %.2f, %.2f\n", s.requests, float64(rate), float64(s.requests-s.errors)/(attack+wait).Seconds())
+	fmt.Printf("Duration      [total, attack, wait]             %s, %s, %s\n", vegetaDuration(attack+wait), vegetaDuration(attack), vegetaDuration(wait))
+	fmt.Printf("Latencies     [min, mean, 50, 90, 95, 99, max]  %s, %s, %s, %s, %s, %s, %s\n",
+		vegetaDuration(s.sorted[0]), vegetaDuration(s.mean), vegetaDuration(s.percentile(50)), vegetaDuration(s.percentile(90)),
+		vegetaDuration(s.percentile(95)), vegetaDuration(s.percentile(99)), vegetaDuration(s.sorted[len(s.sorted)-1]))
+	fmt.Printf("Bytes In      [total, mean]                     %d, %.2f\n", s.bytes, float64(s.bytes)/float64(s.requests))
+	fmt.Printf("Bytes Out     [total, mean]                     0, 0.00\n")
+	fmt.Printf("Success       [ratio]                           %.2f%%\n", success*100)
+	var codes []int
+	for code := range s.statuses {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+	var parts []string
+	for _, code := range codes {
+		parts = append(parts, fmt.Sprintf("%d:%d", code, s.statuses[code]))
+	}
+	fmt.Printf("Status Codes  [code:count]                      %s\n", strings.Join(parts, "  "))
+	fmt.Println("Error Set:")
+	for _, code := range codes {
+		if code >= 400 {
+			fmt.Println(red(fmt.Sprintf("%d %s", code, http.StatusText(code))))
+		}
+	}
+}
+
+// printLoadHistogram 按 vegeta 的 hist 报告格式输出延迟分布
+func printLoadHistogram(s loadStats) {
+	counts := make([]int, len(loadBuckets))
+	for _, d := range s.sorted {
+		i := sort.Search(len(loadBuckets), func(i int) bool { return loadBuckets[i] > d }) - 1
+		counts[i]++
+	}
+	fmt.Printf("%-18s %-7s %-8s %s\n", "Bucket", "#", "%", "Histogram")
+	for i, lo := range loadBuckets {
+		hi := "+Inf"
+		if i+1 < len(loadBuckets) {
+			hi = loadBuckets[i+1].String()
+		}
+		ratio := float64(counts[i]) / float64(len(s.sorted))
+		line := fmt.Sprintf("%-18s %-7d %-8s %s", fmt.Sprintf("[%-7s %s]", lo.String()+",", hi), int(math.Round(float64(counts[i])*s.weight)),
+			fmt.Sprintf("%.2f%%", ratio*100), strings.Repeat("#", int(ratio*75)))
+		fmt.Println(strings.TrimRight(line, " "))
+	}
+}
+
+// loadThreshold 一条压测阈值
+type loadThreshold struct {
+	name   string
+	actual string
+	ok     bool
+}
+
+// runLoadTest 模拟一次 wrk / k6 / vegeta 压测：实时 RPS、最终报告、延迟分布和阈值判定，分位数均由模拟样本计算
+func runLoadTest(config *SessionConfig) {
+	tool := loadTools[rand.Intn(len(loadTools))]
+	route := strings.ReplaceAll(generateEndpoint(config.devType, config.naming), "{id}", strconv.Itoa(rand.Intn(90000)+1000))
+	url := fmt.Sprintf("https://api.%s.internal%s", config.naming.name, route)
+	duration := []int{30, 30, 60}[rand.Intn(3)]
+	model := loadModel{
+		median:  float64(rand.Intn(50) + 12),
+		sigma:   0.35 + rand.Float64()*0.3,
+		tail:    0.005 + rand.Float64()*0.02,
+		errRate: rand.Float64() * 0.004,
+	}
+	if rand.Float32() < 0.3 {
+		model.errRate = 0.008 + rand.Float64()*0.02
+	}
+	respBytes := rand.Intn(2400) + 300
+
+	fmt.Println(blue("🏋️ " + tr("lt.title", url, tool, tfDuration(duration))))
+	threads, conns, rate := 0, 0, 0
+	var samples []loadSample
+	weight := 1.0
+	switch tool {
+	case "wrk":
+		threads = []int{4, 8, 12}[rand.Intn(3)]
+		conns = threads * (rand.Intn(24) + 8)
+		fmt.Println(blue(fmt.Sprintf("$ wrk -t%d -c%d -d%ds --latency %s", threads, conns, duration, url)))
+		fmt.Printf("Running %ds test @ %s\n", duration, url)
+		samples, weight = model.closedLoop(conns, float64(duration))
+	case "k6":
+		conns = []int{50, 100, 200}[rand.Intn(3)]
+		script := fmt.Sprintf("loadtest/%s.js", strings.Trim(strings.ReplaceAll(route, "/", "-"), "-"))
+		fmt.Println(blue(fmt.Sprintf("$ k6 run --vus %d --duration %ds %s", conns, duration, script)))
+		fmt.Printf("\n     execution: local\n        script: %s\n        output: -\n\n", script)
+		fmt.Printf("     scenarios: (100.00%%) 1 scenario, %d max VUs, %ds max duration (incl. graceful stop):\n", conns, duration+30)
+		fmt.Printf("              * default: %d looping VUs for %ds (gracefulStop: 30s)\n\n", conns, duration)
+		samples, weight = model.closedLoop(conns, float64(duration))
+	default:
+		rate = []int{200, 500, 1000, 2000}[rand.Intn(4)]
+		fmt.Println(blue(fmt.Sprintf("$ echo \"GET %s\" | vegeta attack -rate=%d -duration=%ds | tee results.bin | vegeta report", url, rate, duration)))
+		samples, weight = model.openLoop(rate, float64(duration))
+	}
+
+	// 实时计数：每隔几秒汇总一次窗口内的吞吐、p95 和错误；样本按时间排序，一次遍历即可切出所有窗口
+	step := duration / 6
+	next := 0
+	for start := 0; start < duration; start += step {
+		from := next
+		for next < len(samples) && samples[next].at < float64(start+step) {
+			next++
+		}
+		window := samples[from:next]
+		if len(window) == 0 {
+			continue
+		}
+		ws := newLoadStats(window, weight, float64(step), respBytes)
+		elapsed := float64(start + step)
+		if tool == "k6" {
+			done := int(float64(len(samples)) * weight * elapsed / float64(duration))
+			width := 40
+			filled := int(float64(width) * elapsed / float64(duration))
+			fmt.Printf("running (%dm%04.1fs), %03d/%03d VUs, %d complete and 0 interrupted iterations\n", int(elapsed)/60, math.Mod(elapsed, 60), conns, conns, done)
+			fmt.Printf("default   [%s%s] %d VUs  %04.1fs/%ds\n", strings.Repeat("=", filled), strings.Repeat("-", width-filled), conns, elapsed, duration)
+		} else {
+			line := "  ⏱ " + tr("lt.live", tfDuration(int(elapsed)), formatInt(int(ws.rps())), wrkDuration(ws.percentile(95), "µs"), formatPercentFloat(ws.errorRate()*100, 2))
+			if ws.errors > 0 && ws.errorRate() >= 0.01 {
+				line = yellow(line)
+			}
+			fmt.Println(line)
+		}
+		time.Sleep(time.Duration(rand.Intn(200)+250) * time.Millisecond)
+	}
+
+	stats := newLoadStats(samples, weight, float64(duration), respBytes)
+	p95Limit := []time.Duration{100, 200, 300}[rand.Intn(3)] * time.Millisecond
+	p99Limit := p95Limit * 5 / 2
+	thresholds := []loadThreshold{
+		{"p(95) < " + p95Limit.String(), wrkDuration(stats.percentile(95), "µs"), stats.percentile(95) < p95Limit},
+		{"p(99) < " + p99Limit.String(), wrkDuration(stats.percentile(99), "µs"), stats.percentile(99) < p99Limit},
+		{"errors < 1%", formatPercentFloat(stats.errorRate()*100, 2), stats.errorRate() < 0.01},
+	}
+	switch tool {
+	case "wrk":
+		printWrkReport(stats, samples, threads, conns)
+	case "k6":
+		printK6Report(stats, conns, thresholds[0].ok && thresholds[1].ok, thresholds[2].ok)
+	default:
+		printVegetaReport(stats, rate, samples)
+	}
+
+	if tool == "vegeta" {
+		fmt.Println(blue("$ vegeta report -type='hist[0,10ms,25ms,50ms,100ms,250ms,500ms,1s]' results.bin"))
+	} else {
+		fmt.Printf("\n📊 %s\n", tr("lt.buckets"))
+	}
+	printLoadHistogram(stats)
+
+	fmt.Printf("\n🎯 %s\n", tr("lt.thresholds"))
+	passed := 0
+	for _, t := range thresholds {
+		if t.ok {
+			passed++
+			fmt.Println(green(fmt.Sprintf("  ✓ %-16s %s", t.name, t.actual)))
+		} else {
+			fmt.Println(red(fmt.Sprintf("  ✗ %-16s %s", t.name, t.actual)))
+		}
+	}
+	if tool == "k6" && passed < len(thresholds) {
+		var metrics []string
+		if !thresholds[0].ok || !thresholds[1].ok {
+			metrics = append(metrics, "http_req_duration")
+		}
+		if !thresholds[2].ok {
+			metrics = append(metrics, "http_req_failed")
+		}
+		fmt.Println(red(fmt.Sprintf("ERRO[%04d] thresholds on metrics '%s' have been crossed", duration+1, strings.Join(metrics, ", "))))
+	}
+	summary := tr("lt.summary", trn("lt.requests", stats.requests), formatFloat(stats.rps(), 1),
+		wrkDuration(stats.percentile(50), "µs"), wrkDuration(stats.percentile(99), "µs"))
+	if passed == len(thresholds) {
+		fmt.Printf("✅ %s\n", summary)
+	} else {
+		fmt.Printf("❌ %s — %s\n", summary, tr("lt.crossed", passed, len(thresholds)))
+	}
+	if rand.Float32() < jargonDensity(config.jargonLevel) {
+		fmt.Printf("🧠 %s\n", tr("jargon.insight", generatePerformanceJargon(config.devType, config.jargonLevel)))
+	}
+}
@@ -0,0 +1,461 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 日志尾随活动支持的输出格式
+var logFormats = []string{"logfmt", "json", "syslog", "nginx"}
+
+// 会话内保留的最近请求数
+const trafficCapacity = 64
+
+// httpRequest 网络活动中产生的一次请求，日志等活动复用它来保持关联
+type httpRequest struct {
+	method    string
+	route     string // 路由模板，如 /api/v1/users/{id}
+	path      string // 填入具体 ID 后的路径
+	status    int
+	service   string // 处理请求的服务短名
+	upstream  string // 请求依赖的下游服务短名
+	requestID string
+	traceID   string
+	clientIP  string
+	latency   time.Duration
+	bytes     int
+}
+
+// TrafficLog 会话内最近的请求记录
+type TrafficLog struct {
+	requests []httpRequest
+	unread   int // 尚未被日志活动取走的第一个请求的下标
+}
+
+// newTrafficLog 创建空的请求记录
+func newTrafficLog() *TrafficLog {
+	return &TrafficLog{}
+}
+
+// record 记录一次请求并分配请求 ID 和 trace ID
+func (t *TrafficLog) record(method, route string, status int, naming *ProjectNaming) httpRequest {
+	req := httpRequest{
+		method:    method,
+		route:     route,
+		path:      strings.ReplaceAll(route, "{id}", strconv.Itoa(rand.Intn(90000)+1000)),
+		status:    status,
+		service:   "gateway",
+		requestID: newRequestID(),
+		traceID:   randomHex(32),
+		clientIP:  fmt.Sprintf("10.%d.%d.%d", rand.Intn(8), rand.Intn(256), rand.Intn(254)+1),
+		latency:   time.Duration(rand.Intn(180)+2)*time.Millisecond + time.Duration(rand.Intn(1000))*time.Microsecond,
+		bytes:     rand.Intn(8000) + 40,
+		upstream:  naming.randomService(),
+	}
+	for _, s := range naming.services[2:] {
+		if strings.Contains(route, "/"+s) || strings.Contains(route, "."+s+".") {
+			req.service = s
+		}
+	}
+	if strings.Contains(route, "/auth/") {
+		req.service = "auth"
+	}
+	if req.upstream == req.service {
+		req.upstream = "auth"
+	}
+	if status >= 500 {
+		req.latency += time.Duration(rand.Intn(4000)) * time.Millisecond
+	}
+
+	t.requests = append(t.requests, req)
+	if len(t.requests) > trafficCapacity {
+		drop := len(t.requests) - trafficCapacity
+		t.requests = t.requests[drop:]
+		t.unread = max(t.unread-drop, 0)
+	}
+	return req
+}
+
+// take 取走最多 n 个尚未读取的最新请求，避免多次尾随时重复输出同一批请求
+func (t *TrafficLog) take(n int) []httpRequest {
+	start := max(t.unread, len(t.requests)-n)
+	result := append([]httpRequest(nil), t.requests[start:]...)
+	t.unread = len(t.requests)
+	return result
+}
+
+// newRequestID 生成 UUIDv4 格式的请求 ID
+func newRequestID() string {
+	h := randomHex(32)
+	variant := "89ab"[rand.Intn(4)]
+	return fmt.Sprintf("%s-%s-4%s-%c%s-%s", h[:8], h[8:12], h[13:16], variant, h[17:20], h[20:32])
+}
+
+// logField 一个有序的日志字段
+type logField struct {
+	key   string
+	value any
+}
+
+// logEntry 一条结构化日志
+type logEntry struct {
+	time    time.Time
+	level   string // debug / info / warn / error
+	service string
+	msg     string
+	fields  []logField
+	stack   []string
+}
+
+// logValue 按 logfmt 的规则格式化字段值
+func logValue(v any) string {
+	switch x := v.(type) {
+	case string:
+		if x == "" || strings.ContainsAny(x, " \"=") {
+			return strconv.Quote(x)
+		}
+		return x
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64)
+	}
+	return fmt.Sprint(v)
+}
+
+// colorLevel 按日志级别着色
+func colorLevel(level, text string) string {
+	switch level {
+	case "error":
+		return red(text)
+	case "warn":
+		return yellow(text)
+	case "debug":
+		return blue(text)
+	}
+	return text
+}
+
+// formatLogfmt 按 logfmt 格式输出一条日志
+func formatLogfmt(e logEntry) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "time=%s level=%s service=%s msg=%s",
+		e.time.Format(time.RFC3339Nano), e.level, e.service, logValue(e.msg))
+	for _, f := range e.fields {
+		fmt.Fprintf(&b, " %s=%s", f.key, logValue(f.value))
+	}
+	return colorLevel(e.level, b.String())
+}
+
+// formatJSONLog 按 JSON 格式输出一条日志，保留字段顺序
+func formatJSONLog(e logEntry) string {
+	fields := append([]logField{
+		{"ts", e.time.Format(time.RFC3339Nano)},
+		{"level", e.level},
+		{"service", e.service},
+		{"msg", e.msg},
+	}, e.fields...)
+	if len(e.stack) > 0 {
+		fields = append(fields, logField{"stacktrace", strings.Join(e.stack, "\n")})
+	}
+	parts := make([]string, 0, len(fields))
+	for _, f := range fields {
+		key, _ := json.Marshal(f.key)
+		value, _ := json.Marshal(f.value)
+		parts = append(parts, string(key)+":"+string(value))
+	}
+	return colorLevel(e.level, "{"+strings.Join(parts, ",")+"}")
+}
+
+// syslog 严重级别，对应 RFC 5424 的 Severity
+var syslogSeverity = map[string]int{"error": 3, "warn": 4, "info": 6, "debug": 7}
+
+// sdEscape 转义 RFC 5424 结构化数据中的参数值
+func sdEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`).Replace(s)
+}
+
+// formatSyslog 按 RFC 5424 输出一条日志，facility 为 local0
+func formatSyslog(e logEntry, host string, pid int) string {
+	var sd strings.Builder
+	sd.WriteString("[meta@32473")
+	for _, f := range e.fields {
+		fmt.Fprintf(&sd, ` %s="%s"`, strings.ReplaceAll(f.key, ".", "_"), sdEscape(fmt.Sprint(f.value)))
+	}
+	sd.WriteString("]")
+	pri := 16*8 + syslogSeverity[e.level]
+	return colorLevel(e.level, fmt.Sprintf("<%d>1 %s %s %s %d http %s %s",
+		pri, e.time.Format("2006-01-02T15:04:05.000000Z07:00"), host, e.service, pid, sd.String(), e.msg))
+}
+
+// 访问日志中的客户端 UA
+var userAgents = []string{
+	"Mozilla/5.0 (Macintosh; Intel Mac OS X 14_6) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.6 Safari/605.1.15",
+	"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/127.0.0.0 Safari/537.36",
+	"okhttp/4.12.0",
+	"grpc-go/1.65.0",
+	"curl/8.7.1",
+	"kube-probe/1.30",
+}
+
+// formatNginx 按 nginx combined 格式加上耗时和关联 ID 输出一条访问日志
+func formatNginx(req httpRequest, at time.Time) string {
+	proto := "HTTP/1.1"
+	if strings.Contains(req.route, "Service/") {
+		proto = "HTTP/2.0"
+	}
+	rt := req.latency.Seconds()
+	line := fmt.Sprintf(`%s - - [%s] "%s %s %s" %d %d "-" "%s" rt=%.3f uct="%.3f" urt="%.3f" request_id=%s trace_id=%s`,
+		req.clientIP, at.Format("02/Jan/2006:15:04:05 -0700"), req.method, req.path, proto, req.status, req.bytes,
+		userAgents[rand.Intn(len(userAgents))], rt, rand.Float64()*0.002, rt*0.97, req.requestID, req.traceID)
+	switch {
+	case req.status >= 500:
+		return red(line)
+	case req.status >= 400:
+		return yellow(line)
+	}
+	return line
+}
+
+// upstreamErrors nginx 错误日志中与状态码对应的上游错误
+var upstreamErrors = map[int]string{
+	500: "upstream sent invalid header while reading response header from upstream",
+	502: "upstream prematurely closed connection while reading response header from upstream",
+	503: "no live upstreams while connecting to upstream",
+	504: "upstream timed out (110: Connection timed out) while reading response header from upstream",
+}
+
+// requestError 返回与状态码对应的应用错误信息
+func requestError(req httpRequest) string {
+	switch req.status {
+	case 502:
+		return fmt.Sprintf("upstream %s: connection reset by peer", req.upstream)
+	case 503:
+		return fmt.Sprintf("circuit breaker for %s is open", req.upstream)
+	case 504:
+		return "context deadline exceeded"
+	}
+	return fmt.Sprintf("unexpected nil response from %s", req.upstream)
+}
+
+// stackFrames 从虚拟仓库中挑选属于各服务的源文件作为调用栈，处理请求的服务排在栈顶
+func stackFrames(repo *FakeRepo, naming *ProjectNaming, service string) []RepoFile {
+	var own, others []RepoFile
+	for _, f := range repo.sampleFiles(len(repo.files)) {
+		if f.language != repo.language {
+			continue
+		}
+		if strings.Contains(f.dir, service) {
+			own = append(own, f)
+			continue
+		}
+		for _, s := range naming.services {
+			if strings.Contains(f.dir, s) {
+				others = append(others, f)
+				break
+			}
+		}
+	}
+	files := append(own, others...)
+	if len(files) > 4 {
+		files = files[:4]
+	}
+	if len(files) == 0 {
+		files = append(files, repo.randomFile())
+	}
+	return files
+}
+
+// stackTrace 按仓库主语言生成一段调用栈
+func stackTrace(config *SessionConfig, req httpRequest) []string {
+	msg := requestError(req)
+	frames := stackFrames(config.repo, config.naming, req.service)
+	methods := []string{"Handle", "Create", "Process", "Query", "Decode", "Execute"}
+	var lines []string
+	for i, f := range frames {
+		method := methods[rand.Intn(len(methods))]
+		symbol := repoSymbol(f, method, config.naming)
+		line := rand.Intn(max(f.lines-1, 1)) + 1
+		switch config.repo.language {
+		case "go":
+			if i == 0 {
+				lines = append(lines, fmt.Sprintf("goroutine %d [running]:", rand.Intn(900)+20))
+			}
+			lines = append(lines,
+				symbol+"(...)",
+				fmt.Sprintf("\t/app/%s:%d +0x%x", f.path, line, rand.Intn(0x300)+0x20))
+		case "rust":
+			if i == 0 {
+				lines = append(lines, fmt.Sprintf("thread 'tokio-runtime-worker' panicked at %s:%d:%d:", f.path, line, rand.Intn(40)+5), msg, "stack backtrace:")
+			}
+			lines = append(lines,
+				fmt.Sprintf("  %2d: %s", i, symbol),
+				fmt.Sprintf("             at ./%s:%d:%d", f.path, line, rand.Intn(40)+5))
+		case "python":
+			if i == 0 {
+				lines = append(lines, "Traceback (most recent call last):")
+			}
+			lines = append(lines, fmt.Sprintf(`  File "/app/%s", line %d, in %s`, f.path, line, strings.ToLower(method)))
+		case "java":
+			if i == 0 {
+				lines = append(lines, "java.lang.IllegalStateException: "+msg)
+			}
+			lines = append(lines, fmt.Sprintf("\tat %s(%s:%d)", symbol, path.Base(f.path), line))
+		case "typescript", "vue":
+			if i == 0 {
+				lines = append(lines, "Error: "+msg)
+			}
+			lines = append(lines, fmt.Sprintf("    at %s (/app/%s:%d:%d)", symbol, f.path, line, rand.Intn(40)+5))
+		default:
+			if i == 0 {
+				lines = append(lines, "terminate called after throwing an instance of 'std::runtime_error'", "  what():  "+msg)
+			}
+			lines = append(lines, fmt.Sprintf("    #%d 0x%012x in %s() %s:%d", i, rand.Int63n(1<<40), symbol, f.path, line))
+		}
+	}
+	switch config.repo.language {
+	case "python":
+		lines = append(lines, "RuntimeError: "+msg)
+	case "typescript", "vue":
+		lines = append(lines, "    at process.processTicksAndRejections (node:internal/process/task_queues:95:5)")
+	}
+	return lines
+}
+
+// requestEntries 为一次请求生成开始、数据库查询和完成三类日志
+func requestEntries(config *SessionConfig, req httpRequest, start time.Time) []logEntry {
+	service := config.naming.serviceName(req.service)
+	ids := func(extra ...logField) []logField {
+		return append([]logField{
+			{"request_id", req.requestID},
+			{"trace_id", req.traceID},
+			{"span_id", randomHex(16)},
+		}, extra...)
+	}
+	entries := []logEntry{{
+		time: start, level: "info", service: service, msg: "request started",
+		fields: ids(logField{"method", req.method}, logField{"route", req.route}, logField{"path", req.path}, logField{"remote_ip", req.clientIP}),
+	}}
+	if req.service != "gateway" && req.service != "auth" && rand.Float32() < 0.6 {
+		query := req.latency * time.Duration(rand.Intn(50)+20) / 100
+		entries = append(entries, logEntry{
+			time: start.Add(req.latency / 5), level: "debug", service: service, msg: "query executed",
+			fields: ids(logField{"db.name", config.naming.databaseName(req.service)}, logField{"db.operation", []string{"SELECT", "SELECT", "INSERT", "UPDATE"}[rand.Intn(4)]},
+				logField{"db.rows", rand.Intn(200)}, logField{"duration_ms", float64(query.Microseconds()) / 1000}),
+		})
+	}
+
+	done := logEntry{
+		time: start.Add(req.latency), level: "info", service: service, msg: "request completed",
+		fields: ids(logField{"method", req.method}, logField{"path", req.path}, logField{"status", req.status},
+			logField{"duration_ms", float64(req.latency.Microseconds()) / 1000}, logField{"bytes", req.bytes}),
+	}
+	switch {
+	case req.status >= 500:
+		done.level = "error"
+		done.msg = "request failed"
+		done.fields = append(done.fields, logField{"error", requestError(req)})
+		done.stack = stackTrace(config, req)
+	case req.status == 429:
+		done.level = "warn"
+		done.fields = append(done.fields, logField{"reason", "rate limit exceeded"})
+	case req.status >= 400:
+		done.level = "warn"
+	}
+	return append(entries, done)
+}
+
+// logLine 一行待输出的日志，按时间排序后再输出，模拟多个请求交错的效果
+type logLine struct {
+	at    time.Time
+	text  string
+	stack []string
+}
+
+// runLogTail 以选定的格式尾随应用日志，请求与网络活动中出现的一致
+func runLogTail(config *SessionConfig) {
+	format := config.logFormat
+	if format == "" {
+		format = logFormats[rand.Intn(len(logFormats))]
+	}
+
+	// 优先复用网络活动记录的请求，不够时补充新请求
+	n := rand.Intn(4) + 4
+	requests := config.traffic.take(n)
+	for len(requests) < n {
+		requests = append(requests, config.traffic.record(generateMethod(), generateEndpoint(config.devType, config.naming), generateStatus(), config.naming))
+	}
+	config.traffic.take(0)
+
+	if format == "nginx" {
+		fmt.Println(blue("📜 " + tr("logs.title", "/var/log/nginx/access.log", format)))
+		fmt.Println("$ tail -f /var/log/nginx/access.log /var/log/nginx/error.log")
+	} else {
+		fmt.Println(blue("📜 " + tr("logs.title", "deploy/"+config.naming.serviceName("gateway"), format)))
+		fmt.Printf("$ kubectl logs -f -l app.kubernetes.io/part-of=%s -n %s --prefix=false --since=1m\n", config.naming.name, config.naming.namespace)
+	}
+
+	var lines []logLine
+	at := time.Now().Add(-time.Duration(len(requests)) * time.Second)
+	hosts := map[string]string{}
+	pids := map[string]int{}
+	for _, req := range requests {
+		at = at.Add(time.Duration(rand.Intn(900)+50) * time.Millisecond)
+		if format == "nginx" {
+			if msg, ok := upstreamErrors[req.status]; ok {
+				worker := rand.Intn(30) + 20
+				lines = append(lines, logLine{at: at.Add(req.latency), text: red(fmt.Sprintf(
+					`%s [error] %d#%d: *%d %s, client: %s, server: %s, request: "%s %s HTTP/1.1", upstream: "http://10.%d.%d.%d:8080%s", host: "api.%s.internal"`,
+					at.Add(req.latency).Format("2006/01/02 15:04:05"), worker, worker, rand.Intn(900000)+1000, msg, req.clientIP, config.naming.name,
+					req.method, req.path, rand.Intn(8), rand.Intn(256), rand.Intn(254)+1, req.path, config.naming.name))})
+			}
+			lines = append(lines, logLine{at: at.Add(req.latency), text: formatNginx(req, at.Add(req.latency))})
+			continue
+		}
+
+		for _, e := range requestEntries(config, req, at) {
+			line := logLine{at: e.time}
+			switch format {
+			case "json":
+				line.text = formatJSONLog(e)
+			case "syslog":
+				if _, ok := hosts[e.service]; !ok {
+					hosts[e.service] = fmt.Sprintf("%s-%s-%s", e.service, kubeSuffix(10), kubeSuffix(5))
+					pids[e.service] = rand.Intn(30) + 1
+				}
+				line.text = formatSyslog(e, hosts[e.service], pids[e.service])
+			default:
+				line.text = formatLogfmt(e)
+			}
+			// JSON 把调用栈放在 stacktrace 字段里，其他格式逐行输出
+			if format != "json" {
+				line.stack = e.stack
+			}
+			lines = append(lines, line)
+		}
+	}
+	sort.SliceStable(lines, func(i, j int) bool { return lines[i].at.Before(lines[j].at) })
+
+	for _, l := range lines {
+		fmt.Println(l.text)
+		for _, s := range l.stack {
+			fmt.Println(red(s))
+		}
+		time.Sleep(time.Duration(rand.Intn(150)+50) * time.Millisecond)
+	}
+
+	warnings, errors := 0, 0
+	for _, req := range requests {
+		switch {
+		case req.status >= 500:
+			errors++
+		case req.status >= 400:
+			warnings++
+		}
+	}
+	fmt.Printf("📊 %s\n", tr("logs.summary", trn("logs.lines", len(lines)), trn("logs.requests", len(requests)), warnings, errors))
+}
@@ -1,12 +1,14 @@
 package main
 
 import (
+    "flag"
     "fmt"
     "math"
     "math/rand"
     "os"
     "os/signal"
     "sort"
+    "strings"
     "sync/atomic"
     "syscall"
     "time"
@@ -70,6 +72,12 @@ type SessionConfig struct {
 	minimalOutput bool
 	teamActivity  bool
 	framework     string
+	naming        *ProjectNaming
+	repo          *FakeRepo
+	git           *GitState
+	logFormat     string
+	traffic       *TrafficLog
+	profile       *profileFinding
 }
 
 // 全局变量
@@ -115,13 +123,7 @@ func main() {
 		activitiesCount := getActivitiesCount(config.complexity)
 
 		// 随机选择并运行活动
-		activities := []func(*SessionConfig){
-			runCodeAnalysis,
-			runPerformanceMetrics,
-			runSystemMonitoring,
-			runDataProcessing,
-			runNetworkActivity,
-		}
+		activities := getActivities(config)
 		rand.Shuffle(len(activities), func(i, j int) {
 			activities[i], activities[j] = activities[j], activities[i]
 		})
@@ -147,12 +149,11 @@ func main() {
 	}
 
 	fmt.Print("\033[H\033[2J")
-	fmt.Println(green("Session terminated."))
+	fmt.Println(green(tr("session.terminated")))
 }
 
 func parseArgs() *SessionConfig {
-	// 简化起见，这里使用默认配置
-	return &SessionConfig{
+	config := &SessionConfig{
 		devType:       Backend,
 		jargonLevel:   Medium,
 		complexity:    ComplexityMedium,
@@ -162,12 +163,85 @@ func parseArgs() *SessionConfig {
 		teamActivity:  false,
 		framework:     "",
 	}
+	lang := flag.String("lang", "", "output language: en, zh-CN, ja, de (defaults to LC_ALL/LANG)")
+	flag.Var(choiceFlag{logFormats, &config.logFormat}, "log-format", "log tail `format`: "+strings.Join(logFormats, ", ")+" (defaults to a random format per run)")
+	flag.Var(enumFlag[DevelopmentType]{devTypeNames, &config.devType}, "dev-type", "development `type`: "+strings.Join(devTypeNames, ", "))
+	flag.StringVar(&config.framework, "framework", config.framework, "framework `name`, e.g. React, Vue, Django, Spring, Axum, Gin, Unreal (unknown names fall back to the dev type's language)")
+	flag.Var(enumFlag[JargonLevel]{jargonNames, &config.jargonLevel}, "jargon", "jargon `level`: "+strings.Join(jargonNames, ", "))
+	flag.StringVar(&config.projectName, "project", config.projectName, "project name used for services, namespaces and paths")
+	flag.BoolVar(&config.alertsEnabled, "alerts", config.alertsEnabled, "show random system alerts and alerts raised by failed chaos experiments")
+	flag.BoolVar(&config.teamActivity, "team", config.teamActivity, "show teammate activity such as pushes, merges and code reviews")
+	flag.Parse()
+	locale = selectLocale(*lang)
+
+	config.naming = newProjectNaming(config.projectName)
+	config.repo = newFakeRepo(config.devType, config.framework, config.naming)
+	config.git = newGitState(config.naming)
+	config.traffic = newTrafficLog()
+	return config
 }
 
 func getDuration() int64 {
 	return 0 // 默认运行直到中断
 }
 
+// getActivities 返回当前开发类型可用的活动
+func getActivities(config *SessionConfig) []func(*SessionConfig) {
+    activities := []func(*SessionConfig){
+        runCodeAnalysis,
+        runPerformanceMetrics,
+        runSystemMonitoring,
+        runDataProcessing,
+        runNetworkActivity,
+        runBuild,
+        runTests,
+        runGitWorkflow,
+        runLogTail,
+        runTraceWaterfall,
+        runCIPipeline,
+        runLoadTest,
+    }
+
+    switch config.devType {
+    case Backend, Fullstack:
+        activities = append(activities, runDatabaseMigration)
+    case DataScience, MachineLearning:
+        activities = append(activities, runModelTraining)
+    case DevOps:
+        activities = append(activities, runKubernetesRollout, runTerraform)
+    case Blockchain:
+        activities = append(activities, runNodeSync, runContractDeploy)
+    case Security:
+        activities = append(activities, runSecurityScan)
+    case GameDevelopment:
+        activities = append(activities, runGameBuild)
+    }
+    // 游戏开发用引擎自带的帧分析器，其余类型都可以抓取 pprof 剖析
+    if config.devType != GameDevelopment {
+        activities = append(activities, runProfiler)
+    }
+    // goroutine 转储和 gctrace 是 Go 运行时特有的
+    if config.repo.language == "go" {
+        activities = append(activities, runGoroutineDump)
+    }
+    // 依赖安装只模拟 Go 模块、npm 和 Cargo 三种包管理器
+    switch config.repo.language {
+    case "go", "rust", "typescript", "vue":
+        activities = append(activities, runDependencyInstall)
+    }
+    // 后端、运维、系统和安全方向会抓包排查网络问题
+    switch config.devType {
+    case Backend, Fullstack, DevOps, SystemsProgramming, Security:
+        activities = append(activities, runPacketCapture)
+    }
+    // 混沌实验针对部署在 Kubernetes 上的后端服务
+    switch config.devType {
+    case Backend, Fullstack, DevOps:
+        activities = append(activities, runChaosExperiment)
+    }
+    return activities
+}
+
 func getActivitiesCount(complexity Complexity) int {
 	switch complexity {
 	case ComplexityLow:
@@ -184,28 +258,35 @@ func getActivitiesCount(complexity Complexity) int {
 }
 
 func displayBootSequence(config *SessionConfig) {
-	fmt.Println(green("Initializing system..."))
+	fmt.Println(green(tr("boot.init")))
 	time.Sleep(500 * time.Millisecond)
-	fmt.Println(blue("Loading configuration..."))
+	fmt.Println(blue(tr("boot.config")))
 	time.Sleep(300 * time.Millisecond)
-	fmt.Printf("Project: %s\n", yellow(config.projectName))
+	fmt.Println(tr("boot.project", yellow(config.projectName)))
+	fmt.Println(tr("boot.module", yellow(config.naming.modulePath)))
+	fmt.Println(tr("boot.namespace", yellow(config.naming.namespace)))
+	fmt.Println(tr("boot.workspace", yellow(config.naming.repoDir)))
 	if config.framework != "" {
-		fmt.Printf("Framework: %s\n", yellow(config.framework))
+		fmt.Println(tr("boot.framework", yellow(config.framework)))
 	}
 	time.Sleep(500 * time.Millisecond)
 }
 
 // 首先添加必要的依赖
 func runCodeAnalysis(config *SessionConfig) {
-    filesToAnalyze := rand.Intn(20) + 5
-    totalLines := rand.Intn(9000) + 1000
+    files := config.repo.sampleFiles(rand.Intn(20) + 5)
+    filesToAnalyze := len(files)
+    totalLines := 0
+    for _, f := range files {
+        totalLines += f.lines
+    }
 
     title := getCodeAnalysisTitle(config.devType, config.framework)
     fmt.Println(blue(title))
 
     // 创建进度条
     bar := progressbar.NewOptions(filesToAnalyze,
-        progressbar.OptionSetDescription("Analyzing files..."),
+        progressbar.OptionSetDescription(tr("analysis.progress")),
         progressbar.OptionShowCount(),
         progressbar.OptionShowIts(),
         progressbar.OptionSetTheme(progressbar.Theme{
@@ -215,27 +296,50 @@ func runCodeAnalysis(config *SessionConfig) {
             BarEnd:        "]",
         }))
 
-    for i := 0; i < filesToAnalyze; i++ {
+    // 按严重级别统计实际输出的检查结果
+    counts := map[string]int{}
+    fixable := 0
+
+    for _, file := range files {
         bar.Add(1)
         if rand.Float32() < 0.3 {
-            fileName := generateFileName(config.devType)
-            issueType := generateCodeIssue(config.devType)
+            fileName := file.path
             complexity := generateComplexityMetric()
 
             if rand.Float32() < 0.25 {
-                fmt.Printf("  ⚠️ %s - %s: %s\n", fileName, issueType, complexity)
+                findings := generateCodeIssues(config.devType, file, rand.Intn(3)+1)
+                fmt.Printf("\n  ⚠️ %s - %s: %s\n", fileName, issueLabel(findings[0].rule.category), complexity)
+                for _, finding := range findings {
+                    counts[finding.rule.severity]++
+                    fmt.Printf("    %s %s\n", severityLabel(finding.rule.severity), finding.format())
+                    if finding.rule.severity != "info" || rand.Float32() < 0.5 {
+                        fixable++
+                        fmt.Printf("            ↳ %s\n", tr("analysis.fix", finding.fix()))
+                    }
+                }
             } else {
                 fmt.Printf("  ✓ %s - %s\n", fileName, complexity)
             }
+            if rand.Float32() < jargonDensity(config.jargonLevel) {
+                fmt.Printf("    ↳ %s\n", generateCodeJargon(config.devType, config.jargonLevel))
+            }
         }
         time.Sleep(time.Duration(rand.Intn(200)+100) * time.Millisecond)
     }
 
     // 分析总结
-    fmt.Printf("\n📊 Analysis Complete: %d files, %d lines of code\n", filesToAnalyze, totalLines)
-    fmt.Printf("  - Issues found: %d\n", rand.Intn(5))
-    fmt.Printf("  - Code quality score: %d%%\n", rand.Intn(14)+85)
-    fmt.Printf("  - Technical debt: %d%%\n", rand.Intn(14)+1)
+    issues := counts["error"] + counts["warning"] + counts["info"]
+    fmt.Printf("\n📊 %s\n", tr("analysis.complete", trn("analysis.files", filesToAnalyze), trn("analysis.lines", totalLines)))
+    fmt.Printf("  - %s\n", tr("analysis.issues", formatInt(issues),
+        trn("analysis.errors", counts["error"]), trn("analysis.warnings", counts["warning"]), trn("analysis.notes", counts["info"])))
+    if issues > 0 {
+        fmt.Printf("  - %s\n", trn("analysis.fixable", fixable))
+    }
+    // 问题越多，质量分越低
+    quality := min(max(98-counts["error"]*3-counts["warning"]-rand.Intn(4), 0), 100)
+    fmt.Printf("  - %s\n", tr("analysis.quality", formatPercent(quality)))
+    fmt.Printf("  - %s\n", tr("analysis.debt", formatPercent(rand.Intn(6)+1+counts["error"]+counts["warning"]/2)))
+    fmt.Printf("🧠 %s\n", tr("jargon.insight", generateCodeJargon(config.devType, config.jargonLevel)))
 }
 
 // 扩充性能指标功能
@@ -245,7 +349,7 @@ func runPerformanceMetrics(config *SessionConfig) {
 
     iterations := rand.Intn(150) + 50
     bar := progressbar.NewOptions(iterations,
-        progressbar.OptionSetDescription("Collecting metrics..."),
+        progressbar.OptionSetDescription(tr("perf.progress")),
         progressbar.OptionShowCount(),
         progressbar.OptionSetTheme(progressbar.Theme{
             Saucer:        "▰",
@@ -267,7 +371,10 @@ func runPerformanceMetrics(config *SessionConfig) {
             metricName := generatePerformanceMetric(config.devType)
             metricValue := rand.Intn(989) + 10
             metricUnit := generateMetricUnit(config.devType)
-            fmt.Printf("  📊 %s: %d %s\n", metricName, metricValue, metricUnit)
+            fmt.Printf("  📊 %s: %s %s\n", metricName, formatInt(metricValue), metricUnit)
+            if rand.Float32() < jargonDensity(config.jargonLevel) {
+                fmt.Printf("    ↳ %s\n", generatePerformanceJargon(config.devType, config.jargonLevel))
+            }
         }
 
         time.Sleep(time.Duration(rand.Intn(50)+50) * time.Millisecond)
@@ -280,23 +387,29 @@ func runPerformanceMetrics(config *SessionConfig) {
     p95 := performanceData[int(float64(len(performanceData))*0.95)]
     p99 := performanceData[int(float64(len(performanceData))*0.99)]
 
-    fmt.Println("\n📈 Performance Results:")
-    fmt.Printf("  - Average: %.2f ms\n", avg)
-    fmt.Printf("  - Median: %.2f ms\n", median)
-    fmt.Printf("  - P95: %.2f ms\n", p95)
-    fmt.Printf("  - P99: %.2f ms\n", p99)
-
-    // 添加优化建议
-    fmt.Printf("💡 Recommendation: %s\n", generateOptimizationRecommendation(config.devType))
+    fmt.Printf("\n📈 %s\n", tr("perf.results"))
+    fmt.Printf("  - %s\n", tr("perf.average", formatFloat(avg, 2)))
+    fmt.Printf("  - %s\n", tr("perf.median", formatFloat(median, 2)))
+    fmt.Printf("  - %s\n", tr("perf.p95", formatFloat(p95, 2)))
+    fmt.Printf("  - %s\n", tr("perf.p99", formatFloat(p99, 2)))
+
+    // 添加优化建议，有剖析结果时以其热点为依据
+    recommendation := generateOptimizationRecommendation(config.devType)
+    if p := config.profile; p != nil {
+        fmt.Printf("🎯 %s\n", tr("prof.hotspot", p.function, formatPercentFloat(p.share*100, 1), tr("prof."+p.kind)))
+        recommendation = p.recommendation
+    }
+    fmt.Printf("💡 %s\n", tr("perf.recommendation", recommendation))
+    fmt.Printf("🧠 %s\n", tr("jargon.insight", generatePerformanceJargon(config.devType, config.jargonLevel)))
 }
 
 // 扩充系统监控功能
 func runSystemMonitoring(config *SessionConfig) {
-    fmt.Println(green("🖥️ System Resource Monitoring"))
+    fmt.Println(green("🖥️ " + tr("monitor.title")))
 
     duration := rand.Intn(10) + 5
     bar := progressbar.NewOptions(duration,
-        progressbar.OptionSetDescription("Monitoring..."),
+        progressbar.OptionSetDescription(tr("monitor.progress")),
         progressbar.OptionShowCount(),
         progressbar.OptionSetTheme(progressbar.Theme{
             Saucer:        "▰",
@@ -322,27 +435,48 @@ func runSystemMonitoring(config *SessionConfig) {
         cpuStr := formatResourceValue(cpu, 80, 60)
         memStr := formatResourceValue(memory, 85, 70)
 
-        fmt.Printf("  CPU: %s  |  RAM: %s  |  Network: %d MB/s  |  Disk I/O: %d MB/s  |  Processes: %d\n",
-            cpuStr, memStr, network, disk, processes)
+        fmt.Printf("  %s\n", tr("monitor.line",
+            cpuStr, memStr, formatInt(network), formatInt(disk), formatInt(processes)))
 
         if i%3 == 0 && rand.Float32() < 0.3 {
-            fmt.Printf("  🔄 %s\n", generateSystemEvent())
+            fmt.Printf("  🔄 %s\n", generateSystemEvent(config.naming))
+            if rand.Float32() < jargonDensity(config.jargonLevel) {
+                fmt.Printf("    ↳ %s\n", generateJargon(config.devType, config.jargonLevel))
+            }
         }
 
         time.Sleep(time.Duration(rand.Intn(300)+200) * time.Millisecond)
     }
 
     // 显示总结
-    fmt.Println("\n📊 Resource Utilization Summary:")
-    fmt.Printf("  - Peak CPU: %d%%\n", cpuBase+rand.Intn(10)+5)
-    fmt.Printf("  - Peak Memory: %d%%\n", memoryBase+rand.Intn(10)+5)
-    fmt.Printf("  - Network Throughput: %d MB/s\n", networkBase+rand.Intn(5)+5)
-    fmt.Printf("  - Disk Throughput: %d MB/s\n", diskBase+rand.Intn(6)+2)
+    fmt.Printf("\n📊 %s\n", tr("monitor.summary"))
+    fmt.Printf("  - %s\n", tr("monitor.peakCPU", formatPercent(cpuBase+rand.Intn(10)+5)))
+    fmt.Printf("  - %s\n", tr("monitor.peakMemory", formatPercent(memoryBase+rand.Intn(10)+5)))
+    fmt.Printf("  - %s\n", tr("monitor.network", formatInt(networkBase+rand.Intn(5)+5)))
+    fmt.Printf("  - %s\n", tr("monitor.disk", formatInt(diskBase+rand.Intn(6)+2)))
     fmt.Printf("  - %s\n", generateSystemRecommendation())
+    fmt.Printf("🧠 %s\n", tr("jargon.insight", generateJargon(config.devType, config.jargonLevel)))
 }
 
 // 添加术语生成器函数
 func generateCodeJargon(devType DevelopmentType, level JargonLevel) string {
+    plainTerms := map[DevelopmentType][]string{
+        Backend: {
+            "Made the database queries faster",
+            "Cleaned up the login code",
+            "Fixed a few slow API calls",
+            "Added retries for when another service is down",
+            "Split a big function into smaller ones",
+        },
+        Frontend: {
+            "Made the page load faster",
+            "Removed unused code from the bundle",
+            "Fixed layout jumps while the page loads",
+            "Loaded images only when they scroll into view",
+            "Tidied up the stylesheets",
+        },
+    }
+
     basicTerms := map[DevelopmentType][]string{
         Backend: {
             "Optimized query execution paths for improved database throughput",
@@ -379,34 +513,27 @@ func generateCodeJargon(devType DevelopmentType, level JargonLevel) string {
         // ... 其他开发类型的高级术语 ...
     }
 
-    extremeTerms := []string{
-        "Implemented isomorphic polymorphic runtime with transpiled metaprogramming for cross-paradigm interoperability",
-        "Utilized quantum-resistant cryptographic primitives with homomorphic computation capabilities",
-        "Applied non-euclidean topology optimization for multi-dimensional data representation",
-        "Implemented stochastic gradient Langevin dynamics with cyclical annealing for robust convergence",
-        "Utilized differentiable neural computers with external memory addressing for complex reasoning tasks",
-    }
-
-    switch level {
-    case Low, Medium:
-        if terms, ok := basicTerms[devType]; ok && len(terms) > 0 {
-            return terms[rand.Intn(len(terms))]
-        }
-    case High:
-        if terms, ok := advancedTerms[devType]; ok && len(terms) > 0 {
-            return terms[rand.Intn(len(terms))]
-        }
-    case Expert:
-        if rand.Float32() < 0.7 {
-            return extremeTerms[rand.Intn(len(extremeTerms))]
-        } else if terms, ok := advancedTerms[devType]; ok && len(terms) > 0 {
-            return terms[rand.Intn(len(terms))]
-        }
-    }
-    return "Optimizing system performance and resource utilization"
+    return getRandomTerm(devType, level, jargonTiers{plainTerms, basicTerms, advancedTerms})
 }
 
 func generatePerformanceJargon(devType DevelopmentType, level JargonLevel) string {
+    plainTerms := map[DevelopmentType][]string{
+        Backend: {
+            "Reused database connections instead of opening new ones",
+            "Cached answers to common requests",
+            "Sped up a slow query",
+            "Handled more requests at the same time",
+            "Limited how often clients can call the API",
+        },
+        Frontend: {
+            "Made the page draw faster",
+            "Loaded less code on startup",
+            "Made the download smaller",
+            "Loaded the important parts of the page first",
+            "Sent fewer requests to the server",
+        },
+    }
+
     basicTerms := map[DevelopmentType][]string{
         Backend: {
             "Optimized request handling with connection pooling",
@@ -424,11 +551,45 @@ func generatePerformanceJargon(devType DevelopmentType, level JargonLevel) strin
         },
     }
 
+    advancedTerms := map[DevelopmentType][]string{
+        Backend: {
+            "Implemented lock-free ring buffers for zero-contention request dispatch",
+            "Applied profile-guided optimization to hot request paths",
+            "Utilized adaptive load shedding driven by queueing theory models",
+            "Implemented NUMA-aware thread pinning for cache locality",
+            "Applied tail-latency mitigation with budgeted request hedging",
+        },
+        Frontend: {
+            "Implemented off-main-thread rendering with OffscreenCanvas workers",
+            "Applied speculative prerendering with navigation prediction",
+            "Utilized fine-grained reactivity to eliminate virtual DOM overhead",
+            "Implemented streaming server rendering with selective hydration",
+            "Applied compositor-only animations to avoid main-thread jank",
+        },
+    }
+
     // ... 其他性能相关术语 ...
-    return getRandomTerm(devType, level, basicTerms)
+    return getRandomTerm(devType, level, jargonTiers{plainTerms, basicTerms, advancedTerms})
 }
 
 func generateDataJargon(devType DevelopmentType, level JargonLevel) string {
+    plainTerms := map[DevelopmentType][]string{
+        DataScience: {
+            "Cleaned up the input data",
+            "Put all the numbers on the same scale",
+            "Checked the results on data the model had not seen",
+            "Removed duplicate rows",
+            "Combined a few models into one",
+        },
+        Backend: {
+            "Moved old records to cheaper storage",
+            "Removed duplicate entries",
+            "Checked that the data adds up",
+            "Processed records in bigger batches",
+            "Made the nightly import faster",
+        },
+    }
+
     basicTerms := map[DevelopmentType][]string{
         DataScience: {
             "Applied feature normalization for improved model convergence",
@@ -444,13 +605,54 @@ func generateDataJargon(devType DevelopmentType, level JargonLevel) string {
             "Applied dimensionality reduction for feature space optimization",
             "Implemented ensemble methods for improved prediction accuracy",
         },
+        Backend: {
+            "Implemented change data capture for incremental synchronization",
+            "Applied idempotent batch processing with checkpointing",
+            "Utilized columnar storage for analytical query workloads",
+            "Implemented schema validation at ingestion boundaries",
+            "Applied partition pruning for faster range scans",
+        },
+    }
+
+    advancedTerms := map[DevelopmentType][]string{
+        DataScience: {
+            "Applied causal inference with doubly robust estimators for treatment effect analysis",
+            "Implemented Bayesian hierarchical models with partial pooling across cohorts",
+            "Utilized conformal prediction for distribution-free uncertainty quantification",
+            "Applied manifold learning with UMAP for topology-preserving embeddings",
+            "Implemented adversarial validation to detect covariate shift",
+        },
+        MachineLearning: {
+            "Implemented contrastive self-supervised pretraining with momentum encoders",
+            "Applied mixed-precision training with dynamic loss scaling",
+            "Utilized low-rank adaptation for parameter-efficient fine-tuning",
+            "Implemented curriculum learning with difficulty-aware sampling",
+            "Applied knowledge distillation with temperature-scaled soft targets",
+        },
+        Backend: {
+            "Implemented exactly-once stream processing with transactional outbox semantics",
+            "Applied log-structured merge compaction tuning for write amplification reduction",
+            "Utilized vectorized execution over Apache Arrow record batches",
+            "Implemented watermark-based windowing for out-of-order event handling",
+            "Applied bloom-filter-assisted join pruning across partitioned datasets",
+        },
     }
 
     // ... 其他数据相关术语 ...
-    return getRandomTerm(devType, level, basicTerms)
+    return getRandomTerm(devType, level, jargonTiers{plainTerms, basicTerms, advancedTerms})
 }
 
 func generateNetworkJargon(devType DevelopmentType, level JargonLevel) string {
+    plainTerms := map[DevelopmentType][]string{
+        Backend: {
+            "Sent fewer, larger requests",
+            "Kept connections open for reuse",
+            "Compressed responses",
+            "Retried failed requests after a short wait",
+            "Sent several requests at once",
+        },
+    }
+
     basicTerms := map[DevelopmentType][]string{
         Backend: {
             "Optimized request batching for reduced network overhead",
@@ -459,21 +661,178 @@ func generateNetworkJargon(devType DevelopmentType, level JargonLevel) string {
             "Utilized HTTP/2 multiplexing for parallel requests",
             "Implemented retry strategies with exponential backoff",
         },
+        Frontend: {
+            "Implemented HTTP caching with stale-while-revalidate",
+            "Applied resource hints for early connection setup",
+            "Utilized service worker caching for offline support",
+            "Batched analytics beacons to reduce request count",
+            "Implemented request deduplication for concurrent fetches",
+        },
+    }
+
+    advancedTerms := map[DevelopmentType][]string{
+        Backend: {
+            "Implemented adaptive concurrency limits with gradient-based congestion control",
+            "Applied consistent hashing with bounded loads for connection affinity",
+            "Utilized QUIC 0-RTT resumption with seamless connection migration",
+            "Implemented hedged requests with tail-latency-aware retry budgets",
+            "Applied eBPF socket redirection to bypass the kernel network stack",
+        },
     }
 
     // ... 其他网络相关术语 ...
-    return getRandomTerm(devType, level, basicTerms)
+    return getRandomTerm(devType, level, jargonTiers{plainTerms, basicTerms, advancedTerms})
+}
+
+// jargonTiers 按术语级别分层的术语表
+type jargonTiers struct {
+    plain    map[DevelopmentType][]string // Low：通俗说法
+    basic    map[DevelopmentType][]string // Medium
+    advanced map[DevelopmentType][]string // High，Expert 也会混用
+}
+
+// Expert 级别专用的极端术语
+var extremeTerms = []string{
+    "Implemented isomorphic polymorphic runtime with transpiled metaprogramming for cross-paradigm interoperability",
+    "Utilized quantum-resistant cryptographic primitives with homomorphic computation capabilities",
+    "Applied non-euclidean topology optimization for multi-dimensional data representation",
+    "Implemented stochastic gradient Langevin dynamics with cyclical annealing for robust convergence",
+    "Utilized differentiable neural computers with external memory addressing for complex reasoning tasks",
 }
 
-// 辅助函数，用于随机选择术语
-func getRandomTerm(devType DevelopmentType, level JargonLevel, terms map[DevelopmentType][]string) string {
-    if termList, ok := terms[devType]; ok && len(termList) > 0 {
-        return termList[rand.Intn(len(termList))]
+// 某开发类型没有术语时，依次尝试的相近类型
+var jargonFallback = map[DevelopmentType]DevelopmentType{
+    Fullstack:          Backend,
+    DevOps:             Backend,
+    Security:           Backend,
+    SystemsProgramming: Backend,
+    Blockchain:         Backend,
+    MachineLearning:    DataScience,
+    DataScience:        Backend,
+    GameDevelopment:    Frontend,
+    Frontend:           Backend,
+}
+
+// lookupTerms 查找开发类型的术语，找不到时沿回退链查找
+func lookupTerms(terms map[DevelopmentType][]string, devType DevelopmentType) []string {
+    for i := 0; i < 4; i++ {
+        if termList, ok := terms[devType]; ok && len(termList) > 0 {
+            return termList
+        }
+        next, ok := jargonFallback[devType]
+        if !ok {
+            break
+        }
+        devType = next
+    }
+    return nil
+}
+
+// 辅助函数，按术语级别随机选择术语
+func getRandomTerm(devType DevelopmentType, level JargonLevel, tiers jargonTiers) string {
+    plain := lookupTerms(tiers.plain, devType)
+    basic := lookupTerms(tiers.basic, devType)
+    advanced := lookupTerms(tiers.advanced, devType)
+
+    switch level {
+    case Low:
+        if len(plain) > 0 {
+            return plain[rand.Intn(len(plain))]
+        }
+    case Medium:
+        if len(basic) > 0 {
+            return basic[rand.Intn(len(basic))]
+        }
+    case High:
+        if len(advanced) > 0 {
+            return advanced[rand.Intn(len(advanced))]
+        }
+        if len(basic) > 0 {
+            return basic[rand.Intn(len(basic))]
+        }
+    case Expert:
+        term := extremeTerms[rand.Intn(len(extremeTerms))]
+        if len(advanced) > 0 && rand.Float32() < 0.3 {
+            term = advanced[rand.Intn(len(advanced))]
+        }
+        // 专家级别会再叠加一层术语
+        if len(advanced) > 1 && rand.Float32() < 0.5 {
+            extra := advanced[rand.Intn(len(advanced))]
+            for extra == term {
+                extra = advanced[rand.Intn(len(advanced))]
+            }
+            term += ", then " + lowerFirst(extra)
+        }
+        return term
+    }
+    if level == Low {
+        return "Made things faster"
     }
     return "Optimizing system performance"
 }
 
+// lowerFirst 将首字母转为小写，用于拼接术语
+func lowerFirst(s string) string {
+    if s == "" {
+        return s
+    }
+    return strings.ToLower(s[:1]) + s[1:]
+}
+
+// jargonDensity 返回在输出中插入术语的概率
+func jargonDensity(level JargonLevel) float32 {
+    switch level {
+    case Low:
+        return 0.05
+    case Medium:
+        return 0.2
+    case High:
+        return 0.45
+    case Expert:
+        return 0.8
+    }
+    return 0.2
+}
+
 func generateJargon(devType DevelopmentType, level JargonLevel) string {
+    plainTerms := map[DevelopmentType][]string{
+        Backend: {
+            "Made the database faster",
+            "Stopped one broken service from taking down the others",
+            "Cleaned up how users log in",
+            "Made the servers handle more traffic",
+            "Fixed a slow page on the admin site",
+        },
+        DataScience: {
+            "Made the model less likely to memorize the data",
+            "Picked the most useful columns",
+            "Ran the numbers on more machines at once",
+            "Double-checked the results were not a fluke",
+            "Cleaned up the data before training",
+        },
+        Blockchain: {
+            "Made transactions confirm faster",
+            "Checked blocks more efficiently",
+            "Kept transaction details private",
+            "Moved some transactions off the main chain",
+            "Made nodes agree with each other more reliably",
+        },
+        GameDevelopment: {
+            "Kept the frame rate steady",
+            "Made collisions cheaper to check",
+            "Drew faraway objects with less detail",
+            "Drew many copies of the same object at once",
+            "Made the physics behave the same every time",
+        },
+        Security: {
+            "Tightened who can access what",
+            "Added more layers of protection",
+            "Encrypted data sent between services",
+            "Thought through how attackers might get in",
+            "Stopped trusting requests just because they come from inside",
+        },
+    }
+
     basicTerms := map[DevelopmentType][]string{
         Backend: {
             "Optimized query execution paths for improved database throughput",
@@ -550,40 +909,15 @@ func generateJargon(devType DevelopmentType, level JargonLevel) string {
         },
     }
 
-    extremeTerms := []string{
-        "Implemented isomorphic polymorphic runtime with transpiled metaprogramming for cross-paradigm interoperability",
-        "Utilized quantum-resistant cryptographic primitives with homomorphic computation capabilities",
-        "Applied non-euclidean topology optimization for multi-dimensional data representation",
-        "Implemented stochastic gradient Langevin dynamics with cyclical annealing for robust convergence",
-        "Utilized differentiable neural computers with external memory addressing for complex reasoning tasks",
-    }
-
-    switch level {
-    case Low, Medium:
-        if terms, ok := basicTerms[devType]; ok && len(terms) > 0 {
-            return terms[rand.Intn(len(terms))]
-        }
-    case High:
-        if terms, ok := advancedTerms[devType]; ok && len(terms) > 0 {
-            return terms[rand.Intn(len(terms))]
-        }
-    case Expert:
-        if rand.Float32() < 0.7 {
-            return extremeTerms[rand.Intn(len(extremeTerms))]
-        } else if terms, ok := advancedTerms[devType]; ok && len(terms) > 0 {
-            return terms[rand.Intn(len(terms))]
-        }
-    }
-
-    return "Optimizing system performance and resource utilization"
+    return getRandomTerm(devType, level, jargonTiers{plainTerms, basicTerms, advancedTerms})
 }
 
 func runDataProcessing(config *SessionConfig) {
-    fmt.Println(blue("📊 Processing Data Streams"))
+    fmt.Println(blue("📊 " + tr("data.title")))
     
     dataPoints := rand.Intn(1000) + 500
     bar := progressbar.NewOptions(dataPoints,
-        progressbar.OptionSetDescription("Processing data..."),
+        progressbar.OptionSetDescription(tr("data.progress")),
         progressbar.OptionShowCount(),
         progressbar.OptionSetTheme(progressbar.Theme{
             Saucer:        "▰",
@@ -597,17 +931,21 @@ func runDataProcessing(config *SessionConfig) {
         if i%50 == 0 {
             operation := generateDataOperation(config.devType)
             subOperation := generateDataSubOperation(config.devType)
+            if rand.Float32() < jargonDensity(config.jargonLevel) {
+                subOperation = generateDataJargon(config.devType, config.jargonLevel)
+            }
             fmt.Printf("  🔄 %s\n", operation)
             fmt.Printf("    ↳ %s\n", subOperation)
         }
         time.Sleep(time.Duration(rand.Intn(50)+20) * time.Millisecond)
     }
 
-    fmt.Printf("\n✅ Processed %d data points\n", dataPoints)
-    fmt.Printf("💡 Results: %s\n", generateDataDetails(config.devType))
+    fmt.Printf("\n✅ %s\n", trn("data.processed", dataPoints))
+    fmt.Printf("💡 %s\n", tr("data.results", generateDataDetails(config.devType)))
+    fmt.Printf("🧠 %s\n", tr("jargon.insight", generateDataJargon(config.devType, config.jargonLevel)))
 }
 
-func generateEndpoint(devType DevelopmentType) string {
+func generateEndpoint(devType DevelopmentType, naming *ProjectNaming) string {
     endpoints := map[DevelopmentType][]string{
         Backend: {
             "/api/v1/users",
@@ -646,6 +984,18 @@ func generateEndpoint(devType DevelopmentType) string {
         // ... 其他开发类型的端点与 Rust 代码保持一致 ...
     }
 
+    // 部分请求落在项目自己的服务上
+    if rand.Float32() < 0.35 {
+        service := naming.randomService()
+        projectEndpoints := []string{
+            fmt.Sprintf("/api/v1/%s", service),
+            fmt.Sprintf("/api/v1/%s/{id}", service),
+            fmt.Sprintf("/internal/%s/health", service),
+            naming.grpcMethod(service),
+        }
+        return projectEndpoints[rand.Intn(len(projectEndpoints))]
+    }
+
     if endpointList, ok := endpoints[devType]; ok && len(endpointList) > 0 {
         return endpointList[rand.Intn(len(endpointList))]
     }
@@ -700,7 +1050,9 @@ func generateStatus() int {
     return 200
 }
 
-func generateRequestDetails(devType DevelopmentType) string {
+func generateRequestDetails(devType DevelopmentType, naming *ProjectNaming) string {
+    downstream := naming.randomServices(2)
+    service := naming.randomService()
     details := map[DevelopmentType][]string{
         Backend: {
             "Content-Type: application/json, User authenticated, Rate limit: 1000/hour",
@@ -710,7 +1062,9 @@ func generateRequestDetails(devType DevelopmentType) string {
             "Response compression: gzip, Caching: public, max-age=3600",
             "API version: v1, Deprecation warning: Use v2 endpoint",
             "Rate limited client: example-corp, Remaining: 240/minute",
-            "Downstream services: payment-service, notification-service",
+            fmt.Sprintf("Downstream services: %s, %s", naming.serviceName(downstream[0]), naming.serviceName(downstream[1])),
+            fmt.Sprintf("Routed to %s.%s.svc.cluster.local, Upstream latency: %dms", naming.serviceName(service), naming.namespace, rand.Intn(40)+2),
+            fmt.Sprintf("Database: %s, Pool: %d/50 connections", naming.databaseName(service), rand.Intn(40)+5),
             "Tenant: acme-corp, Shard: eu-central-1-b, Replica: 3",
             "Auth scopes: read:users,write:orders, Principal: system-service",
         },
@@ -720,16 +1074,16 @@ func generateRequestDetails(devType DevelopmentType) string {
     if detailList, ok := details[devType]; ok && len(detailList) > 0 {
         return detailList[rand.Intn(len(detailList))]
     }
-    return "Request processed successfully"
+    return fmt.Sprintf("Request processed successfully by %s", naming.serviceName(service))
 }
 
 // 更新现有的 runNetworkActivity 函数
 func runNetworkActivity(config *SessionConfig) {
-    fmt.Println(yellow("🌐 Monitoring Network Activity"))
+    fmt.Println(yellow("🌐 " + tr("network.title")))
     
     packets := rand.Intn(200) + 100
     bar := progressbar.NewOptions(packets,
-        progressbar.OptionSetDescription("Analyzing network..."),
+        progressbar.OptionSetDescription(tr("network.progress")),
         progressbar.OptionShowCount(),
         progressbar.OptionSetTheme(progressbar.Theme{
             Saucer:        "▰",
@@ -742,9 +1096,10 @@ func runNetworkActivity(config *SessionConfig) {
         bar.Add(1)
         if i%20 == 0 {
             method := generateMethod()
-            endpoint := generateEndpoint(config.devType)
+            endpoint := generateEndpoint(config.devType, config.naming)
             status := generateStatus()
-            details := generateRequestDetails(config.devType)
+            details := generateRequestDetails(config.devType, config.naming)
+            config.traffic.record(method, endpoint, status, config.naming)
             
             statusColor := green
             if status >= 400 {
@@ -755,89 +1110,73 @@ func runNetworkActivity(config *SessionConfig) {
             
             fmt.Printf("  📡 %s %s → %s\n", method, endpoint, statusColor(fmt.Sprintf("%d", status)))
             fmt.Printf("     ↳ %s\n", details)
+            if rand.Float32() < jargonDensity(config.jargonLevel) {
+                fmt.Printf("     ↳ %s\n", generateNetworkJargon(config.devType, config.jargonLevel))
+            }
         }
         time.Sleep(time.Duration(rand.Intn(100)+50) * time.Millisecond)
     }
 
-    fmt.Printf("\n📊 Network Analysis Complete\n")
-    fmt.Printf("💡 Optimization: %s\n", generateNetworkJargon(config.devType, config.jargonLevel))
+    fmt.Printf("\n📊 %s\n", tr("network.complete"))
+    fmt.Printf("💡 %s\n", tr("network.optimization", generateNetworkJargon(config.devType, config.jargonLevel)))
 }
 
 func displayRandomAlert(config *SessionConfig) {
     alerts := []string{
-        "⚠️ High memory usage detected in worker process",
-        "🔄 Auto-scaling triggered due to increased load",
-        "📈 Performance threshold exceeded in API endpoint",
-        "🔍 Unusual pattern detected in request flow",
-        "⚡ Cache hit ratio below optimal threshold",
+        "⚠️ " + tr("alert.memory"),
+        "🔄 " + tr("alert.autoscale"),
+        "📈 " + tr("alert.threshold"),
+        "🔍 " + tr("alert.pattern"),
+        "⚡ " + tr("alert.cache"),
     }
-    fmt.Printf("\n%s\n", alerts[rand.Intn(len(alerts))])
+    displayAlert(config, alerts[rand.Intn(len(alerts))])
 }
 
-func displayTeamActivity(config *SessionConfig) {
-    activities := []string{
-        "👩‍💻 Team member pushing code updates",
-        "👨‍💻 Code review in progress",
-        "🤝 Merge request approved",
-        "📝 Documentation update submitted",
-        "🔧 Configuration changes deployed",
-    }
-    fmt.Printf("\n%s\n", activities[rand.Intn(len(activities))])
-}
-
-func getCodeAnalysisTitle(devType DevelopmentType, framework string) string {
-    frameworkStr := ""
-    if framework != "" {
-        frameworkStr = fmt.Sprintf(" (%s)", framework)
+// displayAlert 输出一条告警，并按术语密度附上缓解措施
+func displayAlert(config *SessionConfig, alert string) {
+    fmt.Printf("\n%s\n", alert)
+    if rand.Float32() < jargonDensity(config.jargonLevel) {
+        fmt.Printf("  ↳ %s\n", tr("alert.mitigation", generateJargon(config.devType, config.jargonLevel)))
     }
-    return fmt.Sprintf("🔍 Running Code Analysis%s", frameworkStr)
 }
 
-func generateFileName(devType DevelopmentType) string {
-    extensions := map[DevelopmentType][]string{
-        Backend:     {".go", ".rs", ".java", ".py"},
-        Frontend:    {".js", ".ts", ".vue", ".jsx"},
-        Fullstack:   {".ts", ".go", ".py", ".jsx"},
+func displayTeamActivity(config *SessionConfig) {
+    // 代码评审展开为完整的 diff 和评论，而不是一行提示
+    if rand.Intn(5) == 0 {
+        fmt.Println()
+        runCodeReview(config)
+        return
     }
-
-    prefixes := []string{"service", "controller", "model", "util", "helper"}
-    names := []string{"user", "auth", "data", "config", "api"}
-
-    ext := ".go"
-    if exts, ok := extensions[devType]; ok {
-        ext = exts[rand.Intn(len(exts))]
+    activities := []struct{ icon, key string }{
+        {"👩‍💻", "team.push"},
+        {"🤝", "team.merge"},
+        {"📝", "team.docs"},
+        {"🔧", "team.config"},
     }
-
-    prefix := prefixes[rand.Intn(len(prefixes))]
-    name := names[rand.Intn(len(names))]
-
-    return fmt.Sprintf("%s_%s%s", prefix, name, ext)
-}
-
-func generateCodeIssue(devType DevelopmentType) string {
-    issues := []string{
-        "Potential memory leak",
-        "Uncaught exception",
-        "Resource not released",
-        "Inefficient algorithm",
-        "Security vulnerability",
+    picked := activities[rand.Intn(len(activities))]
+    activity := picked.icon + " " + tr(picked.key)
+    if picked.key == "team.merge" {
+        // 优先合并本次会话中推送的分支
+        if pr := config.git.mergeRandomPR(); pr != nil {
+            activity = picked.icon + " " + tr("team.mergePR", pr.number, pr.branch)
+        }
+    }
+    if rand.Float32() < jargonDensity(config.jargonLevel) {
+        activity += " — " + generateCodeJargon(config.devType, config.jargonLevel)
     }
-    return issues[rand.Intn(len(issues))]
+    fmt.Printf("\n%s\n", activity)
 }
 
-func generateComplexityMetric() string {
-    metrics := []string{
-        "Cyclomatic complexity: 15",
-        "Cognitive complexity: 8",
-        "Maintainability index: 75",
-        "Code coverage: 85%",
-        "Technical debt ratio: 5%",
+func getCodeAnalysisTitle(devType DevelopmentType, framework string) string {
+    frameworkStr := ""
+    if framework != "" {
+        frameworkStr = fmt.Sprintf(" (%s)", framework)
     }
-    return metrics[rand.Intn(len(metrics))]
+    return "🔍 " + tr("analysis.title", frameworkStr)
 }
 
 func getPerformanceTitle(devType DevelopmentType) string {
-    return "⚡ Performance Analysis"
+    return "⚡ " + tr("perf.title")
 }
 
 func generateBasePerformance(devType DevelopmentType) float64 {
@@ -855,6 +1194,16 @@ func calculateAverage(data []float64) float64 {
     return sum / float64(len(data))
 }
 
+// randomHex 生成 n 位随机十六进制字符串
+func randomHex(n int) string {
+    const digits = "0123456789abcdef"
+    b := make([]byte, n)
+    for i := range b {
+        b[i] = digits[rand.Intn(len(digits))]
+    }
+    return string(b)
+}
+
 func generateDataOperation(devType DevelopmentType) string {
     operations := map[DevelopmentType][]string{
         Backend: {
@@ -1045,28 +1394,29 @@ func formatResourceValue(value, warningThreshold, criticalThreshold int) string
     return green(fmt.Sprintf("%d%%", value))
 }
 
-func generateSystemEvent() string {
+func generateSystemEvent(naming *ProjectNaming) string {
+    service := naming.serviceName(naming.randomService())
     events := []string{
-        "Container auto-scaling event triggered",
-        "Cache invalidation completed",
-        "Background job processing completed",
-        "System health check passed",
-        "Metrics collection cycle completed",
-        "Log rotation executed",
-        "Configuration refresh completed",
-        "Resource cleanup task executed",
+        tr("event.autoscale", service, naming.namespace),
+        tr("event.cache", service),
+        tr("event.jobs", service),
+        tr("event.health", naming.serviceName("gateway")),
+        tr("event.metrics"),
+        tr("event.logrotate", naming.namespace),
+        tr("event.config", service),
+        tr("event.cleanup", naming.databaseName(naming.randomService())),
     }
     return events[rand.Intn(len(events))]
 }
 
 func generateSystemRecommendation() string {
     recommendations := []string{
-        "Consider increasing cache size for improved performance",
-        "Optimize background job scheduling for better resource utilization",
-        "Review logging levels to reduce I/O overhead",
-        "Consider implementing request rate limiting",
-        "Optimize database connection pool settings",
-        "Review auto-scaling thresholds for better resource efficiency",
+        tr("sysrec.cache"),
+        tr("sysrec.jobs"),
+        tr("sysrec.logging"),
+        tr("sysrec.ratelimit"),
+        tr("sysrec.dbpool"),
+        tr("sysrec.autoscale"),
     }
     return recommendations[rand.Intn(len(recommendations))]
 }
@@ -1083,9 +1433,9 @@ func runPerformanceAnalysis(config *SessionConfig) {
         value := generateBasePerformance(config.devType)
         performanceData[i] = value
         
-        fmt.Printf("  📊 %s: %.2f %s\n", metric, value, unit)
+        fmt.Printf("  📊 %s: %s %s\n", metric, formatFloat(value, 2), unit)
         time.Sleep(time.Duration(rand.Intn(300)+200) * time.Millisecond)
     }
     
-    fmt.Printf("\n💡 Optimization: %s\n", generateOptimizationRecommendation(config.devType))
+    fmt.Printf("\n💡 %s\n", tr("network.optimization", generateOptimizationRecommendation(config.devType)))
 }
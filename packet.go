@@ -0,0 +1,776 @@
+package main
+
+import (
+	"crypto/md5"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TCP 标志位
+const (
+	tcpFIN = 0x01
+	tcpSYN = 0x02
+	tcpRST = 0x04
+	tcpPSH = 0x08
+	tcpACK = 0x10
+)
+
+// synOptions Linux 在 SYN 中携带的 TCP 选项：mss 1460,nop,nop,sackOK,nop,wscale 7
+var synOptions = []byte{0x02, 0x04, 0x05, 0xb4, 0x01, 0x01, 0x04, 0x02, 0x01, 0x03, 0x03, 0x07}
+
+// tcpSegment 一个 IPv4/TCP 报文
+type tcpSegment struct {
+	at           time.Time
+	fromClient   bool
+	src, dst     [4]byte
+	sport, dport uint16
+	id           uint16
+	ttl          byte
+	seq, ack     uint32
+	flags        byte
+	window       uint16
+	options      []byte
+	payload      []byte
+}
+
+// internetChecksum 计算 RFC 1071 的反码和校验和
+func internetChecksum(data []byte) uint16 {
+	var sum uint32
+	for i := 0; i+1 < len(data); i += 2 {
+		sum += uint32(binary.BigEndian.Uint16(data[i:]))
+	}
+	if len(data)%2 == 1 {
+		sum += uint32(data[len(data)-1]) << 8
+	}
+	for sum > 0xffff {
+		sum = sum>>16 + sum&0xffff
+	}
+	return ^uint16(sum)
+}
+
+// tcpPseudoHeader 拼接计算 TCP 校验和用的伪首部和 TCP 报文段
+func tcpPseudoHeader(ip []byte) []byte {
+	segment := ip[20:]
+	pseudo := make([]byte, 12+len(segment))
+	copy(pseudo, ip[12:20])
+	pseudo[9] = 6
+	binary.BigEndian.PutUint16(pseudo[10:], uint16(len(segment)))
+	copy(pseudo[12:], segment)
+	return pseudo
+}
+
+// bytes 按线上格式序列化报文并填入 IP 和 TCP 校验和
+func (s tcpSegment) bytes() []byte {
+	b := make([]byte, 40+len(s.options)+len(s.payload))
+	b[0] = 0x45
+	binary.BigEndian.PutUint16(b[2:], uint16(len(b)))
+	binary.BigEndian.PutUint16(b[4:], s.id)
+	binary.BigEndian.PutUint16(b[6:], 0x4000) // DF
+	b[8] = s.ttl
+	b[9] = 6
+	copy(b[12:], s.src[:])
+	copy(b[16:], s.dst[:])
+	binary.BigEndian.PutUint16(b[10:], internetChecksum(b[:20]))
+
+	t := b[20:]
+	binary.BigEndian.PutUint16(t[0:], s.sport)
+	binary.BigEndian.PutUint16(t[2:], s.dport)
+	binary.BigEndian.PutUint32(t[4:], s.seq)
+	binary.BigEndian.PutUint32(t[8:], s.ack)
+	t[12] = byte((20+len(s.options))/4) << 4
+	t[13] = s.flags
+	binary.BigEndian.PutUint16(t[14:], s.window)
+	copy(t[20:], s.options)
+	copy(t[20+len(s.options):], s.payload)
+	binary.BigEndian.PutUint16(t[16:], internetChecksum(tcpPseudoHeader(b)))
+	return b
+}
+
+// tcpFlow 一条 TCP 连接，负责推进双方的序列号和抓包时间
+type tcpFlow struct {
+	client, server [4]byte
+	cport, sport   uint16
+	cisn, sisn     uint32
+	cnext, snext   uint32 // 双方下一个相对序列号
+	cid, sid       uint16
+	at             time.Time
+	segments       []tcpSegment
+}
+
+// send 在 gap 之后由一方发送一个报文
+func (f *tcpFlow) send(fromClient bool, flags byte, payload []byte, gap time.Duration) {
+	f.at = f.at.Add(gap)
+	s := tcpSegment{at: f.at, fromClient: fromClient, flags: flags, payload: payload}
+	if fromClient {
+		s.src, s.dst, s.sport, s.dport, s.ttl = f.client, f.server, f.cport, f.sport, 64
+		s.seq, s.ack, s.id, s.window = f.cisn+f.cnext, f.sisn+f.snext, f.cid, 502
+		f.cid++
+	} else {
+		s.src, s.dst, s.sport, s.dport, s.ttl = f.server, f.client, f.sport, f.cport, 63
+		s.seq, s.ack, s.id, s.window = f.sisn+f.snext, f.cisn+f.cnext, f.sid, 509
+		f.sid++
+	}
+	if flags&tcpACK == 0 {
+		s.ack = 0
+	}
+	if flags&tcpSYN != 0 {
+		s.options = synOptions
+		s.window = 64240
+		if !fromClient {
+			s.window = 65160
+		}
+	}
+	next := uint32(len(payload))
+	if flags&(tcpSYN|tcpFIN) != 0 {
+		next++
+	}
+	if fromClient {
+		f.cnext += next
+	} else {
+		f.snext += next
+	}
+	f.segments = append(f.segments, s)
+}
+
+// tcpFlagString 按 tcpdump 的写法拼接标志位，如 S.、P.、F.
+func tcpFlagString(flags byte) string {
+	var b strings.Builder
+	for _, f := range []struct {
+		bit    byte
+		letter string
+	}{{tcpFIN, "F"}, {tcpSYN, "S"}, {tcpRST, "R"}, {tcpPSH, "P"}} {
+		if flags&f.bit != 0 {
+			b.WriteString(f.letter)
+		}
+	}
+	if flags&tcpACK != 0 {
+		b.WriteString(".")
+	}
+	return b.String()
+}
+
+// summary 生成一行 tcpdump -nn 输出，握手之后的序列号显示为相对值
+func (f *tcpFlow) summary(s tcpSegment) string {
+	isn, peer := f.cisn, f.sisn
+	if !s.fromClient {
+		isn, peer = f.sisn, f.cisn
+	}
+	var parts []string
+	switch {
+	case s.flags&tcpSYN != 0:
+		parts = append(parts, fmt.Sprintf("seq %d", s.seq))
+		if s.flags&tcpACK != 0 {
+			parts = append(parts, fmt.Sprintf("ack %d", s.ack))
+		}
+	case len(s.payload) > 0:
+		rel := s.seq - isn
+		parts = append(parts, fmt.Sprintf("seq %d:%d", rel, rel+uint32(len(s.payload))), fmt.Sprintf("ack %d", s.ack-peer))
+	case s.flags&tcpFIN != 0:
+		parts = append(parts, fmt.Sprintf("seq %d", s.seq-isn), fmt.Sprintf("ack %d", s.ack-peer))
+	default:
+		parts = append(parts, fmt.Sprintf("ack %d", s.ack-peer))
+	}
+	parts = append(parts, fmt.Sprintf("win %d", s.window))
+	if len(s.options) > 0 {
+		parts = append(parts, "options [mss 1460,nop,nop,sackOK,nop,wscale 7]")
+	}
+	return fmt.Sprintf("%s IP %s.%d > %s.%d: Flags [%s], %s, length %d", s.at.Format("15:04:05.000000"),
+		net.IP(s.src[:]), s.sport, net.IP(s.dst[:]), s.dport, tcpFlagString(s.flags), strings.Join(parts, ", "), len(s.payload))
+}
+
+// hexRegion 十六进制转储中着色的一段，到 end 为止
+type hexRegion struct {
+	end   int
+	color func(a ...interface{}) string
+}
+
+// printHexDump 按 xxd 的格式打印字节，不同协议层用不同颜色区分
+func printHexDump(data []byte, regions []hexRegion) {
+	colorAt := func(i int) func(a ...interface{}) string {
+		for _, r := range regions {
+			if i < r.end {
+				return r.color
+			}
+		}
+		return fmt.Sprint
+	}
+	for off := 0; off < len(data); off += 16 {
+		var line, ascii strings.Builder
+		fmt.Fprintf(&line, "  %08x: ", off)
+		for i := 0; i < 16; i++ {
+			if off+i < len(data) {
+				c := data[off+i]
+				line.WriteString(colorAt(off + i)(hex.EncodeToString([]byte{c})))
+				if c >= 0x20 && c < 0x7f {
+					ascii.WriteByte(c)
+				} else {
+					ascii.WriteByte('.')
+				}
+			} else {
+				line.WriteString("  ")
+			}
+			if i%2 == 1 && i != 15 {
+				line.WriteString(" ")
+			}
+		}
+		fmt.Printf("%s  %s\n", line.String(), ascii.String())
+	}
+}
+
+// printSegmentHeaders 从报文字节中解析并打印 IPv4 和 TCP 首部，校验和重新计算后核对
+func printSegmentHeaders(b []byte) {
+	checked := func(sum uint16, ok bool) string {
+		if ok {
+			return green(fmt.Sprintf("0x%04x [correct]", sum))
+		}
+		return red(fmt.Sprintf("0x%04x [incorrect]", sum))
+	}
+	ipSum := binary.BigEndian.Uint16(b[10:])
+	fmt.Printf("  %s ver %d, ihl %d, tos 0x%02x, id 0x%04x, flags [DF], ttl %d, proto TCP (6), length %d, cksum %s\n",
+		blue("IPv4"), b[0]>>4, int(b[0]&0x0f)*4, b[1], binary.BigEndian.Uint16(b[4:]), b[8],
+		binary.BigEndian.Uint16(b[2:]), checked(ipSum, internetChecksum(b[:20]) == 0))
+
+	t := b[20:]
+	var flags []string
+	for _, f := range []struct {
+		bit  byte
+		name string
+	}{{tcpSYN, "SYN"}, {tcpFIN, "FIN"}, {tcpRST, "RST"}, {tcpPSH, "PSH"}, {tcpACK, "ACK"}} {
+		if t[13]&f.bit != 0 {
+			flags = append(flags, f.name)
+		}
+	}
+	fmt.Printf("  %s  %d > %d, seq %d, ack %d, hlen %d, flags [%s], win %d, cksum %s\n",
+		yellow("TCP"), binary.BigEndian.Uint16(t[0:]), binary.BigEndian.Uint16(t[2:]), binary.BigEndian.Uint32(t[4:]),
+		binary.BigEndian.Uint32(t[8:]), int(t[12]>>4)*4, strings.Join(flags, ", "), binary.BigEndian.Uint16(t[14:]),
+		checked(binary.BigEndian.Uint16(t[16:]), internetChecksum(tcpPseudoHeader(b)) == 0))
+}
+
+// u16 大端序编码 16 位整数
+func u16(n uint16) []byte { return []byte{byte(n >> 8), byte(n)} }
+
+// withLen8 加上 1 字节长度前缀
+func withLen8(b []byte) []byte { return append([]byte{byte(len(b))}, b...) }
+
+// withLen16 加上 2 字节长度前缀
+func withLen16(b []byte) []byte { return append(u16(uint16(len(b))), b...) }
+
+// withLen24 加上 3 字节长度前缀，用于 TLS 握手消息
+func withLen24(b []byte) []byte {
+	return append([]byte{byte(len(b) >> 16), byte(len(b) >> 8), byte(len(b))}, b...)
+}
+
+// randomBytes 生成 n 个随机字节，用作随机数、会话 ID 和密文
+func randomBytes(n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(rand.Intn(256))
+	}
+	return b
+}
+
+// tlsRecord 封装一个 TLS 记录
+func tlsRecord(contentType byte, version uint16, body []byte) []byte {
+	return append(append([]byte{contentType}, u16(version)...), withLen16(body)...)
+}
+
+// tlsExtension 封装一个 TLS 扩展
+func tlsExtension(typ uint16, data []byte) []byte {
+	return append(u16(typ), withLen16(data)...)
+}
+
+// tlsName 一个带名称的 TLS 编号
+type tlsName struct {
+	id   uint16
+	name string
+}
+
+// ClientHello 中提供的密码套件、椭圆曲线和签名算法
+var (
+	tlsCipherSuites = []tlsName{
+		{0x1301, "TLS_AES_128_GCM_SHA256"}, {0x1302, "TLS_AES_256_GCM_SHA384"}, {0x1303, "TLS_CHACHA20_POLY1305_SHA256"},
+		{0xc02b, "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"}, {0xc02f, "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"},
+		{0xc02c, "TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384"}, {0xc030, "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"},
+		{0xcca9, "TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256"}, {0xcca8, "TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256"},
+	}
+	tlsGroups           = []tlsName{{0x001d, "x25519"}, {0x0017, "secp256r1"}, {0x0018, "secp384r1"}}
+	tlsSignatureSchemes = []uint16{0x0403, 0x0804, 0x0401, 0x0503, 0x0805, 0x0501, 0x0806, 0x0601}
+	tlsExtensionNames   = []tlsName{
+		{0x0000, "server_name"}, {0x000a, "supported_groups"}, {0x000d, "signature_algorithms"},
+		{0x0010, "application_layer_protocol_negotiation"}, {0x002b, "supported_versions"},
+		{0x002d, "psk_key_exchange_modes"}, {0x0033, "key_share"},
+	}
+)
+
+// tlsClientHello 构造一个 TLS 1.3 ClientHello 记录，扩展顺序与 tlsExtensionNames 一致
+func tlsClientHello(host string, sessionID []byte) []byte {
+	var suites, groups, sigs []byte
+	for _, s := range tlsCipherSuites {
+		suites = append(suites, u16(s.id)...)
+	}
+	for _, g := range tlsGroups {
+		groups = append(groups, u16(g.id)...)
+	}
+	for _, s := range tlsSignatureSchemes {
+		sigs = append(sigs, u16(s)...)
+	}
+	alpn := append(withLen8([]byte("h2")), withLen8([]byte("http/1.1"))...)
+	keyShare := append(u16(0x001d), withLen16(randomBytes(32))...)
+
+	var exts []byte
+	for _, e := range [][]byte{
+		tlsExtension(0x0000, withLen16(append([]byte{0}, withLen16([]byte(host))...))),
+		tlsExtension(0x000a, withLen16(groups)),
+		tlsExtension(0x000d, withLen16(sigs)),
+		tlsExtension(0x0010, withLen16(alpn)),
+		tlsExtension(0x002b, withLen8([]byte{0x03, 0x04, 0x03, 0x03})),
+		tlsExtension(0x002d, withLen8([]byte{0x01})),
+		tlsExtension(0x0033, withLen16(keyShare)),
+	} {
+		exts = append(exts, e...)
+	}
+
+	body := append(u16(0x0303), randomBytes(32)...)
+	body = append(body, withLen8(sessionID)...)
+	body = append(body, withLen16(suites)...)
+	body = append(body, 1, 0)
+	body = append(body, withLen16(exts)...)
+	return tlsRecord(0x16, 0x0301, append([]byte{0x01}, withLen24(body)...))
+}
+
+// tlsServerFlight 构造服务端的第一轮应答：ServerHello、兼容用的 ChangeCipherSpec 和加密的握手消息
+func tlsServerFlight(sessionID []byte) []byte {
+	exts := append(tlsExtension(0x002b, u16(0x0304)), tlsExtension(0x0033, append(u16(0x001d), withLen16(randomBytes(32))...))...)
+	body := append(u16(0x0303), randomBytes(32)...)
+	body = append(body, withLen8(sessionID)...)
+	body = append(body, u16(0x1301)...)
+	body = append(body, 0)
+	body = append(body, withLen16(exts)...)
+	flight := tlsRecord(0x16, 0x0303, append([]byte{0x02}, withLen24(body)...))
+	flight = append(flight, tlsRecord(0x14, 0x0303, []byte{0x01})...)
+	return append(flight, tlsRecord(0x17, 0x0303, randomBytes(rand.Intn(400)+900))...)
+}
+
+// tlsApplicationData 把明文加密封装为 TLS 1.3 应用数据记录（多出内容类型字节和 16 字节 AEAD 标签）
+func tlsApplicationData(plaintext []byte) []byte {
+	return tlsRecord(0x17, 0x0303, randomBytes(len(plaintext)+17))
+}
+
+// printClientHello 从记录字节中解析并打印 ClientHello 的关键字段和 JA3 指纹
+func printClientHello(record []byte) {
+	hs := record[5:]
+	body := hs[4:]
+	fmt.Printf("  %s Handshake (22), version 0x%04x, length %d; Client Hello (1), length %d\n",
+		green("TLS"), binary.BigEndian.Uint16(record[1:]), binary.BigEndian.Uint16(record[3:]), int(hs[1])<<16|int(hs[2])<<8|int(hs[3]))
+	p := 2 + 32
+	sessionLen := int(body[p])
+	p += 1 + sessionLen
+	suitesLen := int(binary.BigEndian.Uint16(body[p:]))
+	var suites []string
+	var ja3Suites []string
+	for i := 0; i < suitesLen; i += 2 {
+		id := binary.BigEndian.Uint16(body[p+2+i:])
+		ja3Suites = append(ja3Suites, strconv.Itoa(int(id)))
+		for _, s := range tlsCipherSuites {
+			if s.id == id && len(suites) < 3 {
+				suites = append(suites, s.name)
+			}
+		}
+	}
+	p += 2 + suitesLen
+	p += 1 + int(body[p])
+	fmt.Printf("       random %s…, session id %d bytes\n", hex.EncodeToString(body[2:10]), sessionLen)
+	fmt.Printf("       cipher suites (%d): %s, …\n", suitesLen/2, strings.Join(suites, ", "))
+
+	extsEnd := p + 2 + int(binary.BigEndian.Uint16(body[p:]))
+	var ja3Exts, ja3Groups []string
+	for p += 2; p < extsEnd; {
+		typ := binary.BigEndian.Uint16(body[p:])
+		data := body[p+4 : p+4+int(binary.BigEndian.Uint16(body[p+2:]))]
+		p += 4 + len(data)
+		ja3Exts = append(ja3Exts, strconv.Itoa(int(typ)))
+		name := fmt.Sprintf("unknown (%d)", typ)
+		for _, e := range tlsExtensionNames {
+			if e.id == typ {
+				name = e.name
+			}
+		}
+		var values []string
+		switch typ {
+		case 0x0000:
+			values = append(values, string(data[5:]))
+		case 0x000a:
+			for i := 2; i < len(data); i += 2 {
+				id := binary.BigEndian.Uint16(data[i:])
+				ja3Groups = append(ja3Groups, strconv.Itoa(int(id)))
+				for _, g := range tlsGroups {
+					if g.id == id {
+						values = append(values, g.name)
+					}
+				}
+			}
+		case 0x0010:
+			for i := 2; i < len(data); i += 1 + int(data[i]) {
+				values = append(values, string(data[i+1:i+1+int(data[i])]))
+			}
+		case 0x002b:
+			for i := 1; i < len(data); i += 2 {
+				values = append(values, map[uint16]string{0x0304: "TLS 1.3", 0x0303: "TLS 1.2"}[binary.BigEndian.Uint16(data[i:])])
+			}
+		case 0x0033:
+			values = append(values, fmt.Sprintf("x25519 (%d-byte key)", binary.BigEndian.Uint16(data[4:])))
+		default:
+			values = append(values, fmt.Sprintf("%d bytes", len(data)))
+		}
+		fmt.Printf("       extension %s: %s\n", name, strings.Join(values, ", "))
+	}
+
+	// JA3 = TLS版本,密码套件,扩展,曲线,点格式
+	ja3 := fmt.Sprintf("771,%s,%s,%s,", strings.Join(ja3Suites, "-"), strings.Join(ja3Exts, "-"), strings.Join(ja3Groups, "-"))
+	fmt.Printf("       ja3 %x\n", md5.Sum([]byte(ja3)))
+}
+
+// HTTP/2 帧类型和标志
+const (
+	h2Data         = 0x0
+	h2Headers      = 0x1
+	h2Settings     = 0x4
+	h2WindowUpdate = 0x8
+
+	h2EndStream  = 0x1
+	h2Ack        = 0x1
+	h2EndHeaders = 0x4
+)
+
+// h2Preface HTTP/2 客户端连接前言
+const h2Preface = "PRI * HTTP/2.0\r\n\r\nSM\r\n\r\n"
+
+// h2Frame 一个 HTTP/2 帧
+type h2Frame struct {
+	typ, flags byte
+	stream     uint32
+	payload    []byte
+}
+
+// bytes 按线上格式序列化帧：24 位长度、类型、标志和 31 位流 ID
+func (f h2Frame) bytes() []byte {
+	b := []byte{byte(len(f.payload) >> 16), byte(len(f.payload) >> 8), byte(len(f.payload)), f.typ, f.flags}
+	b = binary.BigEndian.AppendUint32(b, f.stream&0x7fffffff)
+	return append(b, f.payload...)
+}
+
+// h2SettingNames HTTP/2 SETTINGS 参数名
+var h2SettingNames = map[uint16]string{
+	1: "HEADER_TABLE_SIZE", 2: "ENABLE_PUSH", 3: "MAX_CONCURRENT_STREAMS",
+	4: "INITIAL_WINDOW_SIZE", 5: "MAX_FRAME_SIZE", 6: "MAX_HEADER_LIST_SIZE",
+}
+
+// h2SettingsFrame 按顺序构造 SETTINGS 帧
+func h2SettingsFrame(settings ...[2]uint32) h2Frame {
+	var payload []byte
+	for _, s := range settings {
+		payload = append(payload, u16(uint16(s[0]))...)
+		payload = binary.BigEndian.AppendUint32(payload, s[1])
+	}
+	return h2Frame{typ: h2Settings, payload: payload}
+}
+
+// headerField 一个 HTTP/2 头部字段
+type headerField struct {
+	name, value string
+}
+
+// hpackStatic 用到的 HPACK 静态表条目（RFC 7541 附录 A）
+var hpackStatic = []struct {
+	index int
+	headerField
+}{
+	{1, headerField{":authority", ""}}, {2, headerField{":method", "GET"}}, {3, headerField{":method", "POST"}},
+	{4, headerField{":path", "/"}}, {7, headerField{":scheme", "https"}}, {8, headerField{":status", "200"}},
+	{9, headerField{":status", "204"}}, {10, headerField{":status", "206"}}, {11, headerField{":status", "304"}},
+	{12, headerField{":status", "400"}}, {13, headerField{":status", "404"}}, {14, headerField{":status", "500"}},
+	{19, headerField{"accept", ""}}, {28, headerField{"content-length", ""}}, {31, headerField{"content-type", ""}},
+	{58, headerField{"user-agent", ""}},
+}
+
+// hpackEncode 编码头部：完全匹配静态表时用索引，否则用带增量索引的字面量，字符串不做 Huffman 编码
+func hpackEncode(fields []headerField) []byte {
+	var block []byte
+	for _, f := range fields {
+		index, nameIndex := 0, 0
+		for _, s := range hpackStatic {
+			if s.name == f.name && s.value == f.value {
+				index = s.index
+				break
+			}
+			if s.name == f.name && nameIndex == 0 {
+				nameIndex = s.index
+			}
+		}
+		if index > 0 {
+			block = append(block, 0x80|byte(index))
+			continue
+		}
+		block = append(block, 0x40|byte(nameIndex))
+		if nameIndex == 0 {
+			block = append(block, withLen8([]byte(f.name))...)
+		}
+		block = append(block, withLen8([]byte(f.value))...)
+	}
+	return block
+}
+
+// hpackDecode 解码 hpackEncode 生成的头部块
+func hpackDecode(block []byte) []headerField {
+	static := func(index int) headerField {
+		for _, s := range hpackStatic {
+			if s.index == index {
+				return s.headerField
+			}
+		}
+		return headerField{name: fmt.Sprintf("<index %d>", index)}
+	}
+	str := func(i int) (string, int) {
+		n := int(block[i])
+		return string(block[i+1 : i+1+n]), i + 1 + n
+	}
+	var fields []headerField
+	for i := 0; i < len(block); {
+		if block[i]&0x80 != 0 {
+			fields = append(fields, static(int(block[i]&0x7f)))
+			i++
+			continue
+		}
+		var f headerField
+		index := int(block[i] & 0x3f)
+		i++
+		if index == 0 {
+			f.name, i = str(i)
+		} else {
+			f.name = static(index).name
+		}
+		f.value, i = str(i)
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// describeFrame 从帧字节中解析出一行 tshark 风格的描述
+func describeFrame(b []byte) string {
+	length := int(b[0])<<16 | int(b[1])<<8 | int(b[2])
+	typ, flags, stream := b[3], b[4], binary.BigEndian.Uint32(b[5:])&0x7fffffff
+	payload := b[9 : 9+length]
+	names := map[byte]string{h2Data: "DATA", h2Headers: "HEADERS", h2Settings: "SETTINGS", h2WindowUpdate: "WINDOW_UPDATE"}
+	var flagNames []string
+	switch {
+	case typ == h2Settings && flags&h2Ack != 0:
+		flagNames = append(flagNames, "ACK")
+	case typ == h2Data || typ == h2Headers:
+		if flags&h2EndStream != 0 {
+			flagNames = append(flagNames, "END_STREAM")
+		}
+		if flags&h2EndHeaders != 0 {
+			flagNames = append(flagNames, "END_HEADERS")
+		}
+	}
+	line := fmt.Sprintf("%s stream=%d len=%d", names[typ], stream, length)
+	if len(flagNames) > 0 {
+		line += fmt.Sprintf(" flags=0x%02x (%s)", flags, strings.Join(flagNames, "|"))
+	}
+	switch typ {
+	case h2Settings:
+		for i := 0; i+6 <= len(payload); i += 6 {
+			line += fmt.Sprintf(" %s=%d", h2SettingNames[binary.BigEndian.Uint16(payload[i:])], binary.BigEndian.Uint32(payload[i+2:]))
+		}
+	case h2WindowUpdate:
+		line += fmt.Sprintf(" increment=%d", binary.BigEndian.Uint32(payload)&0x7fffffff)
+	case h2Data:
+		line += " " + string(payload)
+	}
+	return line
+}
+
+// printH2Frames 打印解密后的 HTTP/2 帧，HEADERS 帧附带十六进制转储和 HPACK 解码结果
+func printH2Frames(arrow string, frames []h2Frame) {
+	for _, f := range frames {
+		b := f.bytes()
+		fmt.Printf("  %s %s\n", arrow, describeFrame(b))
+		if f.typ != h2Headers {
+			continue
+		}
+		printHexDump(b, []hexRegion{{9, yellow}})
+		for _, h := range hpackDecode(f.payload) {
+			fmt.Printf("       %s %s\n", blue(h.name+":"), h.value)
+		}
+	}
+}
+
+// h2UserAgents 各语言 HTTP 客户端的 User-Agent
+var h2UserAgents = map[string]string{
+	"go": "Go-http-client/2.0", "rust": "reqwest/0.12.9", "python": "python-httpx/0.27.2",
+	"java": "Java-http-client/21.0.5", "typescript": "undici", "vue": "undici", "cpp": "curl/8.11.0",
+}
+
+// h2Exchange 构造一次请求/响应交换的双方帧
+func h2Exchange(req httpRequest, host, userAgent string) (request, response []h2Frame) {
+	hasBody := req.method == "POST" || req.method == "PUT" || req.method == "PATCH"
+	reqHeaders := []headerField{
+		{":method", req.method}, {":scheme", "https"}, {":authority", host}, {":path", req.path},
+		{"accept", "application/json"}, {"user-agent", userAgent}, {"x-request-id", req.requestID},
+		{"traceparent", fmt.Sprintf("00-%s-%s-01", req.traceID, randomHex(16))},
+	}
+	reqBody := fmt.Sprintf(`{"id":%d,"version":%d}`, rand.Intn(90000)+1000, rand.Intn(40)+1)
+	if hasBody {
+		reqHeaders = append(reqHeaders, headerField{"content-type", "application/json"}, headerField{"content-length", strconv.Itoa(len(reqBody))})
+	}
+	headers := h2Frame{typ: h2Headers, flags: h2EndHeaders, stream: 1, payload: hpackEncode(reqHeaders)}
+	request = []h2Frame{
+		h2SettingsFrame([2]uint32{2, 0}, [2]uint32{4, 4194304}, [2]uint32{6, 10485760}),
+		{typ: h2WindowUpdate, payload: binary.BigEndian.AppendUint32(nil, 1073741824)},
+		headers,
+	}
+	if hasBody {
+		request = append(request, h2Frame{typ: h2Data, flags: h2EndStream, stream: 1, payload: []byte(reqBody)})
+	} else {
+		request[2].flags |= h2EndStream
+	}
+
+	var respBody string
+	switch {
+	case req.status >= 500:
+		respBody = fmt.Sprintf(`{"error":%q,"request_id":%q}`, requestError(req), req.requestID)
+	case req.status >= 400:
+		respBody = fmt.Sprintf(`{"error":%q,"request_id":%q}`, strings.ToLower(http.StatusText(req.status)), req.requestID)
+	case req.status == 204 || req.status >= 300 || req.method == "HEAD":
+	default:
+		respBody = fmt.Sprintf(`{"id":%d,"status":"ok"}`, rand.Intn(90000)+1000)
+	}
+	respHeaders := []headerField{{":status", strconv.Itoa(req.status)}}
+	if req.status == 301 || req.status == 302 {
+		respHeaders = append(respHeaders, headerField{"location", req.path + "/"})
+	}
+	if respBody != "" {
+		respHeaders = append(respHeaders, headerField{"content-type", "application/json"}, headerField{"content-length", strconv.Itoa(len(respBody))})
+	}
+	respHeaders = append(respHeaders, headerField{"x-request-id", req.requestID})
+	response = []h2Frame{
+		h2SettingsFrame([2]uint32{3, 250}, [2]uint32{4, 1048576}, [2]uint32{5, 16384}),
+		{typ: h2Settings, flags: h2Ack},
+		{typ: h2Headers, flags: h2EndHeaders, stream: 1, payload: hpackEncode(respHeaders)},
+	}
+	if respBody != "" {
+		response = append(response, h2Frame{typ: h2Data, flags: h2EndStream, stream: 1, payload: []byte(respBody)})
+	} else {
+		response[2].flags |= h2EndStream
+	}
+	return request, response
+}
+
+// framesBytes 拼接若干帧的线上字节
+func framesBytes(prefix string, frames []h2Frame) []byte {
+	b := []byte(prefix)
+	for _, f := range frames {
+		b = append(b, f.bytes()...)
+	}
+	return b
+}
+
+// runPacketCapture 模拟抓取一次 HTTPS 请求的数据包：tcpdump 摘要、报文十六进制转储、TLS 握手和解密后的 HTTP/2 帧
+func runPacketCapture(config *SessionConfig) {
+	req := config.traffic.record(generateMethod(), generateEndpoint(config.devType, config.naming), generateStatus(), config.naming)
+	host := fmt.Sprintf("api.%s.internal", config.naming.name)
+	userAgent, ok := h2UserAgents[config.repo.language]
+	if !ok {
+		userAgent = "curl/8.11.0"
+	}
+
+	f := &tcpFlow{
+		server: [4]byte{10, 96, byte(rand.Intn(256)), byte(rand.Intn(254) + 1)},
+		cport:  uint16(rand.Intn(28000) + 32768),
+		sport:  443,
+		cisn:   rand.Uint32(),
+		sisn:   rand.Uint32(),
+		cid:    uint16(rand.Intn(65536)),
+		sid:    uint16(rand.Intn(65536)),
+		at:     time.Now(),
+	}
+	copy(f.client[:], net.ParseIP(req.clientIP).To4())
+	fmt.Println(blue("🦈 " + tr("pcap.title", req.clientIP, net.IP(f.server[:]), f.sport)))
+
+	// 三次握手、TLS 1.3 握手、一次 HTTP/2 请求和四次挥手
+	rtt := time.Duration(rand.Intn(700)+150) * time.Microsecond
+	sessionID := randomBytes(32)
+	clientHello := tlsClientHello(host, sessionID)
+	h2Request, h2Response := h2Exchange(req, host, userAgent)
+	clientFlight := append(tlsRecord(0x14, 0x0303, []byte{0x01}), tlsApplicationData(make([]byte, 36))...)
+	clientFlight = append(clientFlight, tlsApplicationData(framesBytes(h2Preface, h2Request))...)
+
+	f.send(true, tcpSYN, nil, 0)
+	f.send(false, tcpSYN|tcpACK, nil, rtt/2)
+	f.send(true, tcpACK, nil, rtt/2)
+	f.send(true, tcpPSH|tcpACK, clientHello, time.Duration(rand.Intn(60)+20)*time.Microsecond)
+	f.send(false, tcpACK, nil, rtt/2)
+	f.send(false, tcpPSH|tcpACK, tlsServerFlight(sessionID), time.Duration(rand.Intn(400)+200)*time.Microsecond)
+	f.send(true, tcpPSH|tcpACK, clientFlight, rtt/2+time.Duration(rand.Intn(200)+80)*time.Microsecond)
+	handshake := f.at.Sub(f.segments[0].at)
+	requestAt := f.at
+	f.send(false, tcpPSH|tcpACK, tlsApplicationData(framesBytes("", h2Response)), req.latency)
+	latency := f.at.Sub(requestAt)
+	f.send(true, tcpPSH|tcpACK, tlsApplicationData(h2Frame{typ: h2Settings, flags: h2Ack}.bytes()), rtt/2)
+	f.send(true, tcpFIN|tcpACK, nil, time.Duration(rand.Intn(900)+100)*time.Microsecond)
+	f.send(false, tcpFIN|tcpACK, nil, rtt/2)
+	f.send(true, tcpACK, nil, rtt/2)
+
+	fmt.Println(blue(fmt.Sprintf("$ sudo tcpdump -i eth0 -nn -c %d --print -w /tmp/%s.pcap 'host %s and tcp port %d'",
+		len(f.segments), config.naming.name, net.IP(f.server[:]), f.sport)))
+	fmt.Println("tcpdump: listening on eth0, link-type EN10MB (Ethernet), snapshot length 262144 bytes")
+	for _, s := range f.segments {
+		line := f.summary(s)
+		if s.flags&(tcpSYN|tcpFIN) != 0 {
+			line = yellow(line)
+		}
+		fmt.Println(line)
+		time.Sleep(time.Duration(rand.Intn(120)+40) * time.Millisecond)
+	}
+	fmt.Printf("%d packets captured\n%d packets received by filter\n0 packets dropped by kernel\n", len(f.segments), len(f.segments))
+
+	// SYN 和 ClientHello 两个报文展开为十六进制转储并逐层解码
+	for _, i := range []int{0, 3} {
+		b := f.segments[i].bytes()
+		fmt.Printf("\nFrame %d: %d bytes on wire, %s:%d → %s:%d\n", i+1, len(b),
+			net.IP(f.segments[i].src[:]), f.segments[i].sport, net.IP(f.segments[i].dst[:]), f.segments[i].dport)
+		header := 40 + len(f.segments[i].options)
+		printHexDump(b, []hexRegion{{20, blue}, {header, yellow}})
+		printSegmentHeaders(b)
+		if len(f.segments[i].payload) > 0 {
+			printClientHello(f.segments[i].payload)
+		}
+	}
+
+	fmt.Printf("\n🔓 %s\n", tr("pcap.decrypt", "$SSLKEYLOGFILE"))
+	fmt.Printf("  → %s\n", strconv.Quote(h2Preface))
+	printH2Frames("→", h2Request)
+	printH2Frames("←", h2Response)
+
+	fmt.Println()
+	summary := tr("pcap.summary", trn("pcap.packets", len(f.segments)),
+		formatFloat(float64(handshake.Microseconds())/1000, 2), req.method, req.path, req.status, formatFloat(float64(latency.Microseconds())/1000, 2))
+	switch {
+	case req.status >= 500:
+		fmt.Printf("❌ %s\n", summary)
+	case req.status >= 400:
+		fmt.Printf("⚠️ %s\n", summary)
+	default:
+		fmt.Printf("✅ %s\n", summary)
+	}
+	if rand.Float32() < jargonDensity(config.jargonLevel) {
+		fmt.Printf("🧠 %s\n", tr("jargon.insight", generateNetworkJargon(config.devType, config.jargonLevel)))
+	}
+}
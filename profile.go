@@ -0,0 +1,429 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/term"
+)
+
+// 每个 CPU 采样代表的时间和每个堆采样代表的字节数
+const (
+	cpuSampleTime  = 10 * time.Millisecond
+	heapSampleSize = 64 << 10
+)
+
+// profileRuntime 各语言剖析结果中常见的入口函数和运行时叶子函数
+type profileRuntime struct {
+	entry string
+	cpu   []string
+	heap  []string
+}
+
+var profileRuntimes = map[string]profileRuntime{
+	"go": {
+		entry: "net/http.(*conn).serve",
+		cpu:   []string{"runtime.mallocgc", "runtime.memmove", "runtime.mapaccess2_faststr", "syscall.Syscall6", "runtime.futex", "runtime.scanobject"},
+		heap:  []string{"bytes.growSlice", "strings.(*Builder).grow", "bufio.NewReaderSize", "reflect.New", "encoding/json.(*decodeState).object"},
+	},
+	"rust": {
+		entry: "tokio::runtime::task::harness::Harness<T,S>::poll",
+		cpu:   []string{"__memmove_avx_unaligned_erms", "core::hash::sip::Hasher::write", "tokio::runtime::park::Inner::park", "epoll_wait", "__rust_alloc"},
+		heap:  []string{"alloc::raw_vec::RawVec<T,A>::grow_one", "alloc::string::String::push_str", "bytes::bytes_mut::BytesMut::reserve_inner", "hashbrown::raw::RawTable<T,A>::reserve_rehash"},
+	},
+	"python": {
+		entry: "uvicorn.protocols.http.h11_impl.RequestResponseCycle.run_asgi",
+		cpu:   []string{"_PyEval_EvalFrameDefault", "PyObject_Malloc", "dict_subscript", "sock_recv", "gc_collect_main"},
+		heap:  []string{"PyUnicode_New", "list_resize", "dictresize", "_PyBytes_Resize"},
+	},
+	"java": {
+		entry: "org.apache.tomcat.util.threads.ThreadPoolExecutor$Worker.run",
+		cpu:   []string{"java.util.HashMap.getNode", "java.lang.String.hashCode", "jdk.internal.misc.Unsafe.park", "sun.nio.ch.EPoll.wait", "java.util.Arrays.copyOf"},
+		heap:  []string{"java.util.Arrays.copyOf", "java.lang.StringBuilder.toString", "java.util.HashMap.resize", "java.util.ArrayList.grow"},
+	},
+	"typescript": {
+		entry: "processTicksAndRejections",
+		cpu:   []string{"(garbage collector)", "v8::internal::Heap::Scavenge", "uv__io_poll", "Builtins_StringPrototypeReplace", "node::StreamBase::WriteBuffer"},
+		heap:  []string{"Array.prototype.push", "JSON.parse", "Buffer.concat", "String.prototype.split"},
+	},
+	"cpp": {
+		entry: "std::thread::_State_impl::_M_run",
+		cpu:   []string{"malloc", "memcpy", "std::_Hash_bytes", "pthread_mutex_lock", "epoll_wait"},
+		heap:  []string{"operator new", "std::vector<T>::_M_realloc_insert", "std::string::_M_mutate", "std::_Hashtable::_M_rehash"},
+	},
+}
+
+// profileHotspot 一类热点：仓库中的热点方法、它最终落到的库函数以及对应的优化建议
+type profileHotspot struct {
+	method         string
+	callees        map[string]string // 按语言区分，vue 项目的后端沿用 typescript 的库函数
+	recommendation string
+}
+
+var backendHotspots = []profileHotspot{
+	{"FindByID", map[string]string{
+		"go": "database/sql.(*DB).QueryContext", "rust": "sqlx_core::query::Query::fetch_one",
+		"python": "sqlalchemy.engine.base.Connection.execute", "java": "org.postgresql.jdbc.PgPreparedStatement.executeQuery",
+		"typescript": "Client.query", "cpp": "pqxx::transaction_base::exec",
+	}, "Database index optimization could improve query performance"},
+	{"List", map[string]string{
+		"go": "database/sql.(*Rows).Next", "rust": "sqlx_core::query::Query::fetch_all",
+		"python": "sqlalchemy.engine.result.Result.fetchall", "java": "org.postgresql.jdbc.PgResultSet.next",
+		"typescript": "Result.parseRow", "cpp": "pqxx::result::at",
+	}, "Adding a distributed cache layer would reduce database load"},
+	{"Connect", map[string]string{
+		"go": "crypto/tls.(*Conn).HandshakeContext", "rust": "rustls::client::ClientConnection::new",
+		"python": "ssl.SSLSocket.do_handshake", "java": "sun.security.ssl.SSLSocketImpl.startHandshake",
+		"typescript": "TLSSocket._start", "cpp": "SSL_do_handshake",
+	}, "Implement connection pooling to reduce connection overhead"},
+	{"Notify", map[string]string{
+		"go": "net/http.(*Client).Do", "rust": "hyper::client::conn::http1::SendRequest::send_request",
+		"python": "httpx.Client.send", "java": "java.net.http.HttpClient.send",
+		"typescript": "ClientRequest.end", "cpp": "curl_easy_perform",
+	}, "Consider async processing for non-critical operations"},
+	{"Encode", map[string]string{
+		"go": "encoding/json.Marshal", "rust": "serde_json::ser::to_vec",
+		"python": "json.encoder.JSONEncoder.encode", "java": "com.fasterxml.jackson.databind.ObjectMapper.writeValueAsBytes",
+		"typescript": "JSON.stringify", "cpp": "nlohmann::json::dump",
+	}, "Consider implementing request batching for high-volume endpoints"},
+}
+
+var frontendHotspots = []profileHotspot{
+	{"Render", map[string]string{"typescript": "renderWithHooks", "vue": "renderComponentRoot"},
+		"Use memoization for expensive component calculations"},
+	{"RenderRows", map[string]string{"typescript": "reconcileChildFibers", "vue": "patchKeyedChildren"},
+		"Implement virtualization for long scrollable lists"},
+	{"OnScroll", map[string]string{"typescript": "dispatchEvent", "vue": "callWithAsyncErrorHandling"},
+		"Reduce JavaScript execution time with debouncing/throttling"},
+	{"Parse", map[string]string{"typescript": "JSON.parse", "vue": "JSON.parse"},
+		"Consider using web workers for CPU-intensive tasks"},
+}
+
+// callee 返回热点在指定语言中落到的库函数
+func (h profileHotspot) callee(language string) string {
+	if c, ok := h.callees[language]; ok {
+		return c
+	}
+	return h.callees["typescript"]
+}
+
+// profileFinding 最近一次剖析发现的热点，性能指标活动据此给出建议
+type profileFinding struct {
+	function       string
+	share          float64 // 热点函数的 cum 占比
+	kind           string  // cpu 或 heap
+	recommendation string
+}
+
+// profileNode 调用树中的一个函数节点，self 为落在该函数自身的采样数
+type profileNode struct {
+	name     string
+	self     int
+	children []*profileNode
+}
+
+// add 添加一个子节点
+func (n *profileNode) add(name string, self int) *profileNode {
+	c := &profileNode{name: name, self: self}
+	n.children = append(n.children, c)
+	return c
+}
+
+// total 返回以该节点为根的子树的采样总数
+func (n *profileNode) total() int {
+	t := n.self
+	for _, c := range n.children {
+		t += c.total()
+	}
+	return t
+}
+
+// profileEntry pprof -top 的一行
+type profileEntry struct {
+	name      string
+	flat, cum int
+}
+
+// flatten 按函数名汇总 flat 和 cum，同一调用路径上重复出现的函数只计一次 cum
+func flatten(root *profileNode) []profileEntry {
+	stats := map[string]*profileEntry{}
+	var walk func(n *profileNode, onPath map[string]bool)
+	walk = func(n *profileNode, onPath map[string]bool) {
+		e := stats[n.name]
+		if e == nil {
+			e = &profileEntry{name: n.name}
+			stats[n.name] = e
+		}
+		e.flat += n.self
+		if !onPath[n.name] {
+			e.cum += n.total()
+			onPath[n.name] = true
+			defer delete(onPath, n.name)
+		}
+		for _, c := range n.children {
+			walk(c, onPath)
+		}
+	}
+	walk(root, map[string]bool{})
+
+	entries := make([]profileEntry, 0, len(stats))
+	for _, e := range stats {
+		entries = append(entries, *e)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].flat != entries[j].flat {
+			return entries[i].flat > entries[j].flat
+		}
+		if entries[i].cum != entries[j].cum {
+			return entries[i].cum > entries[j].cum
+		}
+		return entries[i].name < entries[j].name
+	})
+	return entries
+}
+
+// buildProfile 用仓库中的函数构造一棵调用树，其中一条路径是本次剖析的热点
+func buildProfile(config *SessionConfig, kind string) (*profileNode, *profileNode, profileHotspot) {
+	language := config.repo.language
+	rt, ok := profileRuntimes[language]
+	if !ok {
+		rt = profileRuntimes["typescript"]
+	}
+	leaves := rt.cpu
+	if kind == "heap" {
+		leaves = rt.heap
+	}
+	hotspots := backendHotspots
+	entry := rt.entry
+	if config.devType == Frontend && (language == "typescript" || language == "vue") {
+		hotspots = frontendHotspots
+		entry = "performWorkUntilDeadline"
+	}
+
+	var files []RepoFile
+	for _, f := range config.repo.sampleFiles(len(config.repo.files)) {
+		if f.language == language {
+			files = append(files, f)
+		}
+	}
+	if len(files) == 0 {
+		files = append(files, config.repo.randomFile())
+	}
+
+	// 按热点所在子树约占三成采样来分配其余节点
+	base := rand.Intn(800) + 2200
+	samples := func(lo, hi float64) int {
+		return int(float64(base) * (lo + rand.Float64()*(hi-lo)))
+	}
+	leaf := func() string {
+		return leaves[rand.Intn(len(leaves))]
+	}
+
+	root := &profileNode{name: entry, self: samples(0.005, 0.02)}
+	order := rand.Perm(len(hotspots))
+	hot := hotspots[order[0]]
+	hotNode := root.add(repoSymbol(files[0], hot.method, config.naming), samples(0.03, 0.06))
+	lib := hotNode.add(hot.callee(language), samples(0.1, 0.18))
+	lib.add(leaf(), samples(0.06, 0.12))
+
+	methods := []string{"Handle", "Validate", "Process", "Decode", "Authorize", "Publish"}
+	for i, f := range files[1:min(len(files), rand.Intn(3)+5)] {
+		n := root.add(repoSymbol(f, methods[rand.Intn(len(methods))], config.naming), samples(0.01, 0.05))
+		leaves := rand.Intn(3)
+		for j := 0; j < leaves; j++ {
+			n.add(leaf(), samples(0.01, 0.04))
+		}
+		if rand.Intn(2) == 0 {
+			other := hotspots[order[1+i%(len(order)-1)]]
+			n.add(other.callee(language), samples(0.02, 0.05)).add(leaf(), samples(0.005, 0.02))
+		}
+	}
+	return root, hotNode, hot
+}
+
+// formatProfileValue 按 pprof 的习惯格式化采样值
+func formatProfileValue(samples int, kind string) string {
+	if kind == "heap" {
+		b := float64(samples * heapSampleSize)
+		if b >= 1<<20 {
+			return fmt.Sprintf("%.2fMB", b/(1<<20))
+		}
+		return fmt.Sprintf("%.2fkB", b/(1<<10))
+	}
+	d := time.Duration(samples) * cpuSampleTime
+	if d < time.Second {
+		return fmt.Sprintf("%dms", d.Milliseconds())
+	}
+	return fmt.Sprintf("%.2fs", d.Seconds())
+}
+
+// printProfileTop 按 go tool pprof -top 的格式打印剖析结果
+func printProfileTop(root *profileNode, kind string, nodeCount int) {
+	total := root.total()
+	entries := flatten(root)
+	shown := entries[:min(nodeCount, len(entries))]
+	accounted := 0
+	for _, e := range shown {
+		accounted += e.flat
+	}
+	pct := func(v int) float64 { return float64(v) / float64(total) * 100 }
+
+	fmt.Printf("Showing nodes accounting for %s, %.2f%% of %s total\n", formatProfileValue(accounted, kind), pct(accounted), formatProfileValue(total, kind))
+	if len(shown) < len(entries) {
+		fmt.Printf("Showing top %d nodes out of %d\n", len(shown), len(entries))
+	}
+	fmt.Printf("%10s %6s %6s %10s %6s\n", "flat", "flat%", "sum%", "cum", "cum%")
+	sum := 0
+	for _, e := range shown {
+		sum += e.flat
+		fmt.Printf("%10s %5.2f%% %5.2f%% %10s %5.2f%%  %s\n", formatProfileValue(e.flat, kind), pct(e.flat), pct(sum),
+			formatProfileValue(e.cum, kind), pct(e.cum), e.name)
+		time.Sleep(time.Duration(rand.Intn(60)+20) * time.Millisecond)
+	}
+}
+
+// flameWidth 返回火焰图可用的宽度，输出不是终端时按 100 列处理
+func flameWidth() int {
+	width, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil || width <= 0 {
+		width = 100
+	}
+	return min(max(width-2, 40), 200)
+}
+
+// flameCell 火焰图中一个函数所占的列区间
+type flameCell struct {
+	from, to int
+	node     *profileNode
+}
+
+// shortSymbol 去掉包路径，只保留火焰图里放得下的函数名
+func shortSymbol(name string) string {
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// printFlameGraph 按终端宽度画出 ASCII 火焰图，根在最底行，热点子树标红、仓库函数标黄
+func printFlameGraph(root, hot *profileNode, width int) {
+	var rows [][]flameCell
+	scale := float64(width) / float64(root.total())
+	var layout func(n *profileNode, depth int, x float64)
+	layout = func(n *profileNode, depth int, x float64) {
+		from := int(math.Round(x))
+		to := int(math.Round(x + float64(n.total())*scale))
+		if to <= from {
+			return
+		}
+		if depth == len(rows) {
+			rows = append(rows, nil)
+		}
+		rows[depth] = append(rows[depth], flameCell{from, to, n})
+		// 和 flamegraph.pl 一样，子节点按函数名排列
+		children := append([]*profileNode(nil), n.children...)
+		sort.Slice(children, func(i, j int) bool { return children[i].name < children[j].name })
+		for _, c := range children {
+			layout(c, depth+1, x)
+			x += float64(c.total()) * scale
+		}
+	}
+	layout(root, 0, 0)
+
+	onHotPath := map[*profileNode]bool{}
+	markSubtree(hot, onHotPath)
+
+	for depth := len(rows) - 1; depth >= 0; depth-- {
+		var b strings.Builder
+		pos := 0
+		for _, c := range rows[depth] {
+			b.WriteString(strings.Repeat(" ", c.from-pos))
+			label := []rune(shortSymbol(c.node.name))
+			body := c.to - c.from - 1
+			if len(label) > body {
+				label = label[:max(body-2, 0)]
+				if body >= 2 {
+					label = append(label, '.', '.')
+				}
+			}
+			segment := "|" + string(label) + strings.Repeat("-", body-len(label))
+			switch {
+			case onHotPath[c.node]:
+				segment = red(segment)
+			case depth == 1:
+				segment = yellow(segment)
+			}
+			b.WriteString(segment)
+			pos = c.to
+		}
+		fmt.Println("  " + b.String())
+		time.Sleep(time.Duration(rand.Intn(80)+40) * time.Millisecond)
+	}
+}
+
+// markSubtree 标记节点及其所有子节点
+func markSubtree(n *profileNode, marked map[*profileNode]bool) {
+	marked[n] = true
+	for _, c := range n.children {
+		markSubtree(c, marked)
+	}
+}
+
+// runProfiler 抓取一份 CPU 或堆剖析，打印 pprof -top 和火焰图，并记录热点供性能指标活动引用
+func runProfiler(config *SessionConfig) {
+	kind := []string{"cpu", "cpu", "heap"}[rand.Intn(3)]
+	service := config.naming.serviceName(config.naming.randomService())
+	root, hotNode, hot := buildProfile(config, kind)
+	hotFn := hotNode.name
+	fmt.Println(blue("🔬 " + tr("prof.title", service, tr("prof."+kind))))
+
+	total := root.total()
+	if config.repo.language == "go" {
+		endpoint := "profile?seconds=30"
+		if kind == "heap" {
+			endpoint = "heap"
+		}
+		url := fmt.Sprintf("http://%s.%s:6060/debug/pprof/%s", service, config.naming.namespace, endpoint)
+		fmt.Printf("$ go tool pprof -top %s\n", url)
+		fmt.Printf("Fetching profile over HTTP from %s\n", url)
+		time.Sleep(time.Duration(rand.Intn(600)+400) * time.Millisecond)
+		sampleTypes := "samples.cpu"
+		if kind == "heap" {
+			sampleTypes = "alloc_objects.alloc_space.inuse_objects.inuse_space"
+		}
+		fmt.Printf("Saved profile in /root/pprof/pprof.%s.%s.001.pb.gz\n", service, sampleTypes)
+	} else {
+		fmt.Printf("$ pprof -top profiles/%s-%s.pb.gz\n", service, kind)
+	}
+	fmt.Printf("File: %s\n", service)
+	if kind == "heap" {
+		fmt.Println("Type: inuse_space")
+	} else {
+		fmt.Println("Type: cpu")
+	}
+	fmt.Printf("Time: %s\n", time.Now().UTC().Format("Jan 2, 2006 at 3:04pm (MST)"))
+	if kind == "cpu" {
+		duration := 30*time.Second + time.Duration(rand.Intn(300))*time.Millisecond
+		fmt.Printf("Duration: %.2fs, Total samples = %s (%.2f%%)\n", duration.Seconds(), formatProfileValue(total, kind),
+			float64(time.Duration(total)*cpuSampleTime)/float64(duration)*100)
+	}
+	printProfileTop(root, kind, 15)
+
+	width := flameWidth()
+	fmt.Printf("\n🔥 %s\n", tr("prof.flame", width))
+	printFlameGraph(root, hotNode, width)
+
+	// 热点函数只出现在一条路径上，其 cum 即子树采样数
+	share := float64(hotNode.total()) / float64(total)
+	config.profile = &profileFinding{function: hotFn, share: share, kind: kind, recommendation: hot.recommendation}
+	fmt.Printf("\n🎯 %s\n", tr("prof.hotspot", hotFn, formatPercentFloat(share*100, 1), tr("prof."+kind)))
+	fmt.Printf("💡 %s\n", tr("perf.recommendation", hot.recommendation))
+	if rand.Float32() < jargonDensity(config.jargonLevel) {
+		fmt.Printf("🧠 %s\n", tr("jargon.insight", generatePerformanceJargon(config.devType, config.jargonLevel)))
+	}
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"strings"
+)
+
+// ProjectNaming 由项目名派生的统一命名方案
+type ProjectNaming struct {
+	name       string   // kebab-case 项目名，如 distributed-cluster
+	slug       string   // snake_case，用于数据库名
+	pkgName    string   // 去掉分隔符的小写名，用于 Go 包名和 proto 包名
+	org        string   // 代码托管组织名
+	modulePath string   // Go module 路径
+	namespace  string   // Kubernetes 命名空间
+	repoDir    string   // 本地仓库目录
+	version    string   // 当前版本号
+	services   []string // 服务短名，第一个始终是网关
+}
+
+// 服务短名候选池
+var servicePool = []string{
+	"orders", "payments", "users", "inventory", "notifications", "billing",
+	"search", "catalog", "scheduler", "ingest", "metrics", "ledger",
+}
+
+// 组织名候选池
+var orgPool = []string{
+	"acme-platform", "initech-eng", "globex-labs", "hooli-infra", "umbrella-dev", "stark-systems",
+}
+
+// newProjectNaming 根据项目名生成命名方案，同一项目名总是得到相同结果
+func newProjectNaming(projectName string) *ProjectNaming {
+	name := normalizeProjectName(projectName)
+
+	h := fnv.New64a()
+	h.Write([]byte(name))
+	r := rand.New(rand.NewSource(int64(h.Sum64())))
+
+	services := []string{"gateway", "auth"}
+	for _, i := range r.Perm(len(servicePool))[:4] {
+		services = append(services, servicePool[i])
+	}
+
+	org := orgPool[r.Intn(len(orgPool))]
+	version := fmt.Sprintf("0.%d.%d", r.Intn(12)+1, r.Intn(9))
+	return &ProjectNaming{
+		name:       name,
+		slug:       strings.ReplaceAll(name, "-", "_"),
+		pkgName:    strings.ReplaceAll(name, "-", ""),
+		org:        org,
+		modulePath: fmt.Sprintf("github.com/%s/%s", org, name),
+		namespace:  name + "-prod",
+		repoDir:    "~/src/" + name,
+		version:    version,
+		services:   services,
+	}
+}
+
+// normalizeProjectName 将任意项目名转换为 kebab-case
+func normalizeProjectName(projectName string) string {
+	var b strings.Builder
+	lastDash := true
+	for _, r := range strings.ToLower(projectName) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			lastDash = false
+		} else if !lastDash {
+			b.WriteByte('-')
+			lastDash = true
+		}
+	}
+	name := strings.Trim(b.String(), "-")
+	if name == "" {
+		return "stakeholder"
+	}
+	return name
+}
+
+// serviceName 返回服务的完整部署名
+func (p *ProjectNaming) serviceName(short string) string {
+	return p.name + "-" + short
+}
+
+// databaseName 返回服务对应的数据库名
+func (p *ProjectNaming) databaseName(short string) string {
+	return p.slug + "_" + short
+}
+
+// randomService 随机返回一个业务服务短名（不含网关和认证）
+func (p *ProjectNaming) randomService() string {
+	return p.services[2+rand.Intn(len(p.services)-2)]
+}
+
+// randomServices 随机返回 n 个不重复的业务服务短名
+func (p *ProjectNaming) randomServices(n int) []string {
+	business := p.services[2:]
+	if n > len(business) {
+		n = len(business)
+	}
+	result := make([]string, 0, n)
+	for _, i := range rand.Perm(len(business))[:n] {
+		result = append(result, business[i])
+	}
+	return result
+}
+
+// grpcMethod 返回服务的 gRPC 方法全名
+func (p *ProjectNaming) grpcMethod(short string) string {
+	methods := []string{"Get", "List", "Create", "Update", "Stream"}
+	return fmt.Sprintf("/%s.%s.v1.%sService/%s%s",
+		p.pkgName, short, exportedName(short), methods[rand.Intn(len(methods))], exportedName(singular(short)))
+}
+
+// exportedName 将短名转换为首字母大写形式
+func exportedName(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// pascalCase 将 kebab-case 名称转换为 PascalCase
+func pascalCase(s string) string {
+	parts := strings.Split(s, "-")
+	for i, part := range parts {
+		parts[i] = exportedName(part)
+	}
+	return strings.Join(parts, "")
+}
+
+// singular 粗略地将复数名词转换为单数
+func singular(s string) string {
+	switch {
+	case strings.HasSuffix(s, "ies"):
+		return strings.TrimSuffix(s, "ies") + "y"
+	case strings.HasSuffix(s, "s") && !strings.HasSuffix(s, "ss"):
+		return strings.TrimSuffix(s, "s")
+	}
+	return s
+}
@@ -0,0 +1,353 @@
+package main
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// RepoFile 虚拟仓库中的一个文件
+type RepoFile struct {
+	path     string
+	dir      string // 所在包目录
+	language string
+	lines    int
+	test     bool
+}
+
+// FakeRepo 会话内固定不变的虚拟仓库
+type FakeRepo struct {
+	language string // 主语言
+	files    []RepoFile
+	dirs     []string // 按字典序排列的包目录
+}
+
+// newFakeRepo 根据开发类型、框架和项目名生成虚拟仓库，同样的输入总是得到同样的仓库
+func newFakeRepo(devType DevelopmentType, framework string, naming *ProjectNaming) *FakeRepo {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s/%d/%s", naming.name, devType, framework)
+	r := rand.New(rand.NewSource(int64(h.Sum64())))
+
+	repo := &FakeRepo{language: primaryLanguage(devType, framework)}
+	b := &repoBuilder{repo: repo, r: r}
+
+	switch repo.language {
+	case "go":
+		b.goLayout(naming)
+	case "rust":
+		b.rustLayout(naming, devType)
+	case "typescript", "vue":
+		b.webLayout(naming, devType, repo.language)
+	case "python":
+		b.pythonLayout(naming, devType)
+	case "java":
+		b.javaLayout(naming)
+	case "cpp":
+		b.cppLayout(naming)
+	}
+
+	seen := map[string]bool{}
+	for _, f := range repo.files {
+		if !seen[f.dir] {
+			seen[f.dir] = true
+			repo.dirs = append(repo.dirs, f.dir)
+		}
+	}
+	sort.Strings(repo.dirs)
+	return repo
+}
+
+// frameworkLanguages 框架关键字与其决定的仓库语言
+var frameworkLanguages = []struct {
+	keywords []string
+	language string
+}{
+	{[]string{"vue", "vuejs", "nuxt", "nuxtjs"}, "vue"},
+	{[]string{"react", "reactjs", "next", "nextjs", "angular", "svelte", "sveltekit", "nest", "nestjs", "express"}, "typescript"},
+	{[]string{"django", "flask", "fastapi", "pytorch", "torch", "tensorflow", "keras", "jax", "flax", "scikit", "sklearn", "pandas"}, "python"},
+	{[]string{"spring", "quarkus", "micronaut"}, "java"},
+	{[]string{"actix", "axum", "rocket", "tokio", "substrate", "bevy"}, "rust"},
+	{[]string{"gin", "echo", "fiber", "chi"}, "go"},
+	{[]string{"unreal", "godot", "sdl"}, "cpp"},
+}
+
+// primaryLanguage 选择仓库主语言，框架优先于开发类型；框架名按整词匹配关键字，无法识别时按开发类型选择
+func primaryLanguage(devType DevelopmentType, framework string) string {
+	words := strings.FieldsFunc(strings.ToLower(framework), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, fl := range frameworkLanguages {
+		for _, k := range fl.keywords {
+			if slices.Contains(words, k) {
+				return fl.language
+			}
+		}
+	}
+
+	switch devType {
+	case Frontend, Fullstack:
+		return "typescript"
+	case DataScience, MachineLearning:
+		return "python"
+	case Blockchain, SystemsProgramming:
+		return "rust"
+	case GameDevelopment:
+		return "cpp"
+	}
+	return "go"
+}
+
+// randomFile 随机返回一个非测试源文件
+func (repo *FakeRepo) randomFile() RepoFile {
+	sources := repo.sourceFiles()
+	return sources[rand.Intn(len(sources))]
+}
+
+// sourceFiles 返回所有非测试文件
+func (repo *FakeRepo) sourceFiles() []RepoFile {
+	var result []RepoFile
+	for _, f := range repo.files {
+		if !f.test {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// testFiles 返回所有测试文件
+func (repo *FakeRepo) testFiles() []RepoFile {
+	var result []RepoFile
+	for _, f := range repo.files {
+		if f.test {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// filesIn 返回某个目录下的文件
+func (repo *FakeRepo) filesIn(dir string) []RepoFile {
+	var result []RepoFile
+	for _, f := range repo.files {
+		if f.dir == dir {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// sampleFiles 随机返回最多 n 个不重复的非测试文件
+func (repo *FakeRepo) sampleFiles(n int) []RepoFile {
+	sources := repo.sourceFiles()
+	if n > len(sources) {
+		n = len(sources)
+	}
+	result := make([]RepoFile, 0, n)
+	for _, i := range rand.Perm(len(sources))[:n] {
+		result = append(result, sources[i])
+	}
+	return result
+}
+
+// rustPath 根据 crates/<name>/src/<module>.rs 推断模块路径，lib.rs 和 main.rs 位于 crate 根
+func rustPath(f RepoFile, naming *ProjectNaming) string {
+	crate := naming.slug
+	if parts := strings.Split(f.dir, "/"); len(parts) >= 2 && parts[0] == "crates" {
+		crate = strings.ReplaceAll(parts[1], "-", "_")
+	}
+	module := strings.TrimSuffix(path.Base(f.path), ".rs")
+	if module == "lib" || module == "main" {
+		return crate
+	}
+	return crate + "::" + module
+}
+
+// repoSymbol 按文件语言的惯例返回其中某个方法的完整符号名，调用栈和剖析输出共用
+func repoSymbol(f RepoFile, method string, naming *ProjectNaming) string {
+	base := path.Base(f.path)
+	name := strings.TrimSuffix(base, path.Ext(base))
+	typeName := pascalCase(strings.NewReplacer("_", "-", ".", "-").Replace(name))
+	switch f.language {
+	case "go":
+		return fmt.Sprintf("%s/%s.(*%s).%s", naming.modulePath, f.dir, typeName, method)
+	case "rust":
+		return rustPath(f, naming) + "::" + strings.ToLower(method)
+	case "python":
+		module := strings.TrimSuffix(strings.TrimSuffix(f.path, ".py"), "/__init__")
+		return strings.ReplaceAll(module, "/", ".") + "." + strings.ToLower(method)
+	case "java":
+		pkg := strings.ReplaceAll(strings.TrimPrefix(f.dir, "src/main/java/"), "/", ".")
+		return fmt.Sprintf("%s.%s.%s", pkg, name, lowerFirst(method))
+	case "typescript", "vue":
+		return typeName + "." + lowerFirst(method)
+	}
+	return typeName + "::" + method
+}
+
+// repoBuilder 生成仓库布局时使用的辅助结构
+type repoBuilder struct {
+	repo *FakeRepo
+	r    *rand.Rand
+}
+
+// add 添加一个文件，行数大致服从长尾分布
+func (b *repoBuilder) add(filePath string, test bool) {
+	lines := 40 + b.r.Intn(160)
+	if b.r.Float32() < 0.3 {
+		lines += b.r.Intn(700)
+	}
+	b.repo.files = append(b.repo.files, RepoFile{
+		path:     filePath,
+		dir:      path.Dir(filePath),
+		language: languageForFile(filePath),
+		lines:    lines,
+		test:     test,
+	})
+}
+
+func (b *repoBuilder) goLayout(naming *ProjectNaming) {
+	b.add(fmt.Sprintf("cmd/%s/main.go", naming.name), false)
+	for _, svc := range naming.services {
+		dir := "internal/" + svc
+		for _, name := range []string{"handler", "service", "repository", "model"} {
+			b.add(fmt.Sprintf("%s/%s.go", dir, name), false)
+			if name != "model" && b.r.Float32() < 0.8 {
+				b.add(fmt.Sprintf("%s/%s_test.go", dir, name), true)
+			}
+		}
+	}
+	b.add("internal/platform/database/postgres.go", false)
+	b.add("internal/platform/database/migrate.go", false)
+	b.add("internal/platform/httpx/middleware.go", false)
+	b.add("internal/platform/httpx/middleware_test.go", true)
+	b.add("internal/platform/telemetry/tracing.go", false)
+	b.add("pkg/client/client.go", false)
+	b.add("pkg/client/client_test.go", true)
+}
+
+func (b *repoBuilder) rustLayout(naming *ProjectNaming, devType DevelopmentType) {
+	crates := append([]string{"core"}, naming.services[2:]...)
+	if devType == Blockchain {
+		crates = []string{"core", "consensus", "mempool", "p2p", "rpc", "state"}
+	}
+	for _, c := range crates {
+		dir := fmt.Sprintf("crates/%s-%s/src", naming.name, c)
+		b.add(dir+"/lib.rs", false)
+		for _, name := range []string{"error", "config", "service", "types"} {
+			if b.r.Float32() < 0.75 {
+				b.add(fmt.Sprintf("%s/%s.rs", dir, name), false)
+			}
+		}
+		b.add(fmt.Sprintf("crates/%s-%s/tests/%s_it.rs", naming.name, c, c), true)
+	}
+	b.add(fmt.Sprintf("crates/%s-cli/src/main.rs", naming.name), false)
+	if devType == Blockchain {
+		for _, c := range []string{"Vault", "Governor", "StakingPool"} {
+			b.add(fmt.Sprintf("contracts/src/%s.sol", c), false)
+			b.add(fmt.Sprintf("contracts/test/%s.t.sol", c), true)
+		}
+	}
+}
+
+func (b *repoBuilder) webLayout(naming *ProjectNaming, devType DevelopmentType, language string) {
+	componentExt := ".tsx"
+	if language == "vue" {
+		componentExt = ".vue"
+	}
+	components := []string{"Dashboard", "NavBar", "DataTable", "UserMenu", "SettingsPanel", "Chart", "Modal"}
+	for _, svc := range naming.services[2:] {
+		components = append(components, exportedName(singular(svc))+"List", exportedName(singular(svc))+"Detail")
+	}
+	for _, c := range components {
+		b.add(fmt.Sprintf("apps/web/src/components/%s%s", c, componentExt), false)
+		if b.r.Float32() < 0.6 {
+			b.add(fmt.Sprintf("apps/web/src/components/%s.test.ts", c), true)
+		}
+	}
+	for _, hook := range []string{"useAuth", "useFetch", "useDebounce", "useFeatureFlag"} {
+		b.add(fmt.Sprintf("apps/web/src/hooks/%s.ts", hook), false)
+	}
+	for _, svc := range naming.services {
+		b.add(fmt.Sprintf("apps/web/src/api/%s.ts", svc), false)
+	}
+	b.add("apps/web/src/store/index.ts", false)
+	b.add("apps/web/src/main.ts", false)
+
+	// 全栈项目附带一个 Node 后端
+	if devType == Fullstack || devType == Backend {
+		for _, svc := range naming.services {
+			dir := fmt.Sprintf("apps/api/src/modules/%s", svc)
+			for _, kind := range []string{"controller", "service", "module"} {
+				b.add(fmt.Sprintf("%s/%s.%s.ts", dir, svc, kind), false)
+			}
+			b.add(fmt.Sprintf("%s/%s.service.spec.ts", dir, svc), true)
+		}
+	}
+}
+
+func (b *repoBuilder) pythonLayout(naming *ProjectNaming, devType DevelopmentType) {
+	if devType == DataScience || devType == MachineLearning {
+		modules := map[string][]string{
+			"data":       {"loaders", "schema", "splits"},
+			"features":   {"engineering", "encoders", "selection"},
+			"models":     {"baseline", "transformer", "ensemble"},
+			"training":   {"trainer", "callbacks", "schedulers"},
+			"evaluation": {"metrics", "reports"},
+		}
+		for _, pkg := range []string{"data", "features", "models", "training", "evaluation"} {
+			b.add(fmt.Sprintf("%s/%s/__init__.py", naming.slug, pkg), false)
+			for _, m := range modules[pkg] {
+				b.add(fmt.Sprintf("%s/%s/%s.py", naming.slug, pkg, m), false)
+				if b.r.Float32() < 0.6 {
+					b.add(fmt.Sprintf("tests/%s/test_%s.py", pkg, m), true)
+				}
+			}
+		}
+		b.add(fmt.Sprintf("%s/cli.py", naming.slug), false)
+		return
+	}
+	for _, svc := range naming.services {
+		b.add(fmt.Sprintf("%s/%s/__init__.py", naming.slug, svc), false)
+		for _, m := range []string{"api", "models", "service", "schemas"} {
+			b.add(fmt.Sprintf("%s/%s/%s.py", naming.slug, svc, m), false)
+		}
+		b.add(fmt.Sprintf("tests/%s/test_service.py", svc), true)
+	}
+	b.add(fmt.Sprintf("%s/settings.py", naming.slug), false)
+}
+
+func (b *repoBuilder) javaLayout(naming *ProjectNaming) {
+	base := fmt.Sprintf("com/%s/%s", strings.ReplaceAll(naming.org, "-", ""), naming.pkgName)
+	for _, svc := range naming.services {
+		entity := exportedName(singular(svc))
+		for _, kind := range []string{"Controller", "Service", "Repository", ""} {
+			b.add(fmt.Sprintf("src/main/java/%s/%s/%s%s.java", base, svc, entity, kind), false)
+		}
+		b.add(fmt.Sprintf("src/test/java/%s/%s/%sServiceTest.java", base, svc, entity), true)
+	}
+	b.add(fmt.Sprintf("src/main/java/%s/Application.java", base), false)
+	b.add(fmt.Sprintf("src/main/java/%s/config/SecurityConfig.java", base), false)
+}
+
+func (b *repoBuilder) cppLayout(naming *ProjectNaming) {
+	module := pascalCase(naming.name)
+	systems := map[string][]string{
+		"Core":      {"Engine", "World", "EntityRegistry"},
+		"Rendering": {"Renderer", "ShaderCache", "MeshBatcher"},
+		"Physics":   {"RigidBody", "BroadPhase", "CollisionSolver"},
+		"Gameplay":  {"PlayerController", "AbilitySystem", "Inventory"},
+		"Audio":     {"AudioMixer", "SoundBank"},
+	}
+	for _, sys := range []string{"Core", "Rendering", "Physics", "Gameplay", "Audio"} {
+		for _, cls := range systems[sys] {
+			b.add(fmt.Sprintf("Source/%s/%s/%s.h", module, sys, cls), false)
+			b.add(fmt.Sprintf("Source/%s/%s/%s.cpp", module, sys, cls), false)
+		}
+		b.add(fmt.Sprintf("Tests/%s/%sTests.cpp", sys, sys), true)
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import "testing"
+
+func TestPrimaryLanguage(t *testing.T) {
+	tests := []struct {
+		devType   DevelopmentType
+		framework string
+		want      string
+	}{
+		// 游戏引擎："engine" 中的 "gin" 不能被当成 Go 框架
+		{GameDevelopment, "Unreal Engine", "cpp"},
+		{GameDevelopment, "Godot Engine", "cpp"},
+		{GameDevelopment, "Custom Engine", "cpp"},
+		{GameDevelopment, "Unity", "cpp"},
+		{GameDevelopment, "SDL2", "cpp"},
+		{GameDevelopment, "Bevy", "rust"},
+		// 机器学习："machine" 中的 "chi" 同理
+		{MachineLearning, "Machine Learning Kit", "python"},
+		{MachineLearning, "PyTorch", "python"},
+		{MachineLearning, "TensorFlow/Keras", "python"},
+		{DataScience, "scikit-learn", "python"},
+		{DataScience, "", "python"},
+		// 后端
+		{Backend, "Gin", "go"},
+		{Backend, "go-chi", "go"},
+		{Backend, "Spring Boot", "java"},
+		{Backend, "Axum", "rust"},
+		{Backend, "FastAPI", "python"},
+		{Backend, "NestJS", "typescript"},
+		{Backend, "Enterprise Service Bus", "go"},
+		{Backend, "", "go"},
+		// 前端
+		{Frontend, "Next.js", "typescript"},
+		{Frontend, "Nuxt", "vue"},
+		{Frontend, "Ember", "typescript"},
+	}
+	for _, tt := range tests {
+		if got := primaryLanguage(tt.devType, tt.framework); got != tt.want {
+			t.Errorf("primaryLanguage(%s, %q) = %s, want %s", devTypeNames[tt.devType], tt.framework, got, tt.want)
+		}
+	}
+}
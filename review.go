@@ -0,0 +1,411 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// 参与代码评审的同事账号
+var teammates = []string{"kpatel", "lnguyen", "jmorales", "sokafor", "rtanaka", "ebrandt", "amuller", "dchen"}
+
+// reviewComment 评审中挂在某一新增行上的评论
+type reviewComment struct {
+	anchor     string // 评论所在的新增行包含的文本
+	key        string // 评论内容的翻译键
+	subject    string // 翻译参数，为空表示没有参数
+	suggestion string // 建议替换成的代码，为空表示没有建议
+	blocking   bool   // 是否需要修改后才能合并
+}
+
+// reviewDiff 一段待评审的改动，行首为 ' '、'-' 或 '+'
+// 文本中的 {Entity}、{entity}、{Entities}、{entities}、{ENTITIES}、{Upstream}、{upstream} 在生成时替换
+type reviewDiff struct {
+	title    string // 合并请求标题，{scope} 为作用域
+	lines    []string
+	comments []reviewComment
+}
+
+// 各语言的候选改动：给列表接口加分页上限，以及给上游调用加超时
+var reviewDiffs = map[string][]reviewDiff{
+	"go": {
+		{
+			title: "feat({scope}): cap page size in List{Entities}",
+			lines: []string{
+				"-func (s *Service) List{Entities}(ctx context.Context, req *List{Entities}Request) ([]*{Entity}, error) {",
+				"+func (s *Service) List{Entities}(ctx context.Context, req *List{Entities}Request) ([]*{Entity}, int, error) {",
+				" \tlimit := int(req.PageSize)",
+				"+\tif limit <= 0 || limit > 500 {",
+				"+\t\tlimit = 100",
+				"+\t}",
+				"+\ttotal, err := s.count{Entities}(ctx)",
+				"+\tif err != nil {",
+				"+\t\treturn nil, 0, err",
+				"+\t}",
+				" \trows, err := s.db.QueryContext(ctx, list{Entities}SQL, limit, req.PageToken)",
+				" \tif err != nil {",
+				"-\t\treturn nil, fmt.Errorf(\"list {entities}: %w\", err)",
+				"+\t\treturn nil, 0, fmt.Errorf(\"list {entities}: %w\", err)",
+				" \t}",
+				" \tdefer rows.Close()",
+			},
+			comments: []reviewComment{
+				{"limit > 500", "review.c.constant", "", "\tif limit <= 0 || limit > maxPageSize {", false},
+				{"return nil, 0, err", "review.c.wrap", "{entities}", "\t\treturn nil, 0, fmt.Errorf(\"count {entities}: %w\", err)", true},
+			},
+		},
+		{
+			title: "fix({scope}): add a timeout to {upstream} lookups",
+			lines: []string{
+				" func (c *{Upstream}Client) Get{Entity}(ctx context.Context, id string) (*{Entity}, error) {",
+				"-\tresp, err := c.http.Get(c.baseURL + \"/{entities}/\" + id)",
+				"+\tctx, cancel := context.WithTimeout(ctx, 2*time.Second)",
+				"+\tdefer cancel()",
+				"+\treq, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+\"/{entities}/\"+id, nil)",
+				"+\tif err != nil {",
+				"+\t\treturn nil, err",
+				"+\t}",
+				"+\tresp, err := c.http.Do(req)",
+				" \tif err != nil {",
+				" \t\treturn nil, fmt.Errorf(\"get {entity} %s: %w\", id, err)",
+				" \t}",
+				" \tdefer resp.Body.Close()",
+			},
+			comments: []reviewComment{
+				{"2*time.Second", "review.c.timeout", "{upstream}", "\tctx, cancel := context.WithTimeout(ctx, c.timeout)", false},
+				{"resp, err := c.http.Do(req)", "review.c.retry", "{upstream}", "", true},
+			},
+		},
+	},
+	"rust": {
+		{
+			title: "feat({scope}): cap page size in list_{entities}",
+			lines: []string{
+				" pub async fn list_{entities}(&self, req: List{Entities}Request) -> Result<Vec<{Entity}>, Error> {",
+				"-    let limit = req.page_size as i64;",
+				"+    let limit = match req.page_size {",
+				"+        0 => 100,",
+				"+        n => n.min(500) as i64,",
+				"+    };",
+				"+    let total: i64 = sqlx::query_scalar(\"SELECT count(*) FROM {entities}\")",
+				"+        .fetch_one(&self.pool)",
+				"+        .await?;",
+				"+    tracing::debug!(total, limit, \"listing {entities}\");",
+				"     let rows = sqlx::query_as::<_, {Entity}>(LIST_{ENTITIES}_SQL)",
+				"         .bind(limit)",
+				"         .fetch_all(&self.pool)",
+			},
+			comments: []reviewComment{
+				{"n.min(500)", "review.c.constant", "", "        n => n.min(MAX_PAGE_SIZE) as i64,", false},
+				{"SELECT count(*)", "review.c.count", "{entities}", "", true},
+			},
+		},
+		{
+			title: "fix({scope}): add a timeout to {upstream} lookups",
+			lines: []string{
+				" pub async fn get_{entity}(&self, id: &str) -> Result<{Entity}, Error> {",
+				"-    let resp = self.http.get(format!(\"{}/{entities}/{}\", self.base_url, id)).send().await?;",
+				"+    let resp = self",
+				"+        .http",
+				"+        .get(format!(\"{}/{entities}/{}\", self.base_url, id))",
+				"+        .timeout(Duration::from_secs(2))",
+				"+        .send()",
+				"+        .await?;",
+				"     resp.error_for_status()?.json().await.map_err(Error::from)",
+				" }",
+			},
+			comments: []reviewComment{
+				{"from_secs(2)", "review.c.timeout", "{upstream}", "        .timeout(self.timeout)", false},
+			},
+		},
+	},
+	"python": {
+		{
+			title: "feat({scope}): cap page size in list_{entities}",
+			lines: []string{
+				"     def list_{entities}(self, page_size: int, page_token: str | None = None) -> list[{Entity}]:",
+				"-        limit = page_size",
+				"+        limit = min(page_size, 500) if page_size > 0 else 100",
+				"+        total = self.session.scalar(select(func.count()).select_from({Entity}))",
+				"         stmt = select({Entity}).order_by({Entity}.id).limit(limit)",
+				"         if page_token:",
+				"             stmt = stmt.where({Entity}.id > decode_token(page_token))",
+				"+        logger.debug(\"listing {entities}\", extra={\"total\": total, \"limit\": limit})",
+				"         return list(self.session.scalars(stmt))",
+			},
+			comments: []reviewComment{
+				{"min(page_size, 500)", "review.c.constant", "", "        limit = min(page_size, MAX_PAGE_SIZE) if page_size > 0 else DEFAULT_PAGE_SIZE", false},
+				{"select_from", "review.c.count", "{entities}", "", true},
+			},
+		},
+		{
+			title: "fix({scope}): add a timeout to {upstream} lookups",
+			lines: []string{
+				"     def get_{entity}(self, {entity}_id: str) -> {Entity}:",
+				"-        resp = self.client.get(f\"/{entities}/{{entity}_id}\")",
+				"+        resp = self.client.get(f\"/{entities}/{{entity}_id}\", timeout=2.0)",
+				"         resp.raise_for_status()",
+				"         return {Entity}.model_validate(resp.json())",
+			},
+			comments: []reviewComment{
+				{"timeout=2.0", "review.c.timeout", "{upstream}", "        resp = self.client.get(f\"/{entities}/{{entity}_id}\", timeout=self.settings.{upstream}_timeout)", false},
+			},
+		},
+	},
+	"java": {
+		{
+			title: "feat({scope}): cap page size in list{Entities}",
+			lines: []string{
+				"     public Page<{Entity}> list{Entities}(int pageSize, String pageToken) {",
+				"-        int limit = pageSize;",
+				"+        int limit = pageSize <= 0 ? 100 : Math.min(pageSize, 500);",
+				"+        long total = {entity}Repository.count();",
+				"         List<{Entity}> items = {entity}Repository.findPage(decodeToken(pageToken), limit);",
+				"-        return new Page<>(items, nextToken(items));",
+				"+        return new Page<>(items, nextToken(items), total);",
+				"     }",
+			},
+			comments: []reviewComment{
+				{"Math.min(pageSize, 500)", "review.c.constant", "", "        int limit = pageSize <= 0 ? DEFAULT_PAGE_SIZE : Math.min(pageSize, MAX_PAGE_SIZE);", false},
+				{"Repository.count()", "review.c.count", "{entities}", "", true},
+			},
+		},
+		{
+			title: "fix({scope}): surface {upstream} outages as a typed exception",
+			lines: []string{
+				"     public {Entity} get{Entity}(String id) {",
+				"-        return restClient.get().uri(\"/{entities}/{id}\", id).retrieve().body({Entity}.class);",
+				"+        return restClient.get()",
+				"+                .uri(\"/{entities}/{id}\", id)",
+				"+                .retrieve()",
+				"+                .onStatus(HttpStatusCode::is5xxServerError, (req, res) -> {",
+				"+                    throw new {Upstream}UnavailableException(res.getStatusCode());",
+				"+                })",
+				"+                .body({Entity}.class);",
+				"     }",
+			},
+			comments: []reviewComment{
+				{"is5xxServerError", "review.c.retry", "{upstream}", "", true},
+			},
+		},
+	},
+	"typescript": {
+		{
+			title: "feat({scope}): cap page size in list{Entities}",
+			lines: []string{
+				" export async function list{Entities}(pageSize: number, pageToken?: string): Promise<Page<{Entity}>> {",
+				"-  const limit = pageSize;",
+				"+  const limit = pageSize > 0 ? Math.min(pageSize, 500) : 100;",
+				"+  const total = await db.{entity}.count();",
+				"   const items = await db.{entity}.findMany({ take: limit, cursor: decodeCursor(pageToken) });",
+				"-  return { items, nextPageToken: encodeCursor(items) };",
+				"+  return { items, total, nextPageToken: encodeCursor(items) };",
+				" }",
+			},
+			comments: []reviewComment{
+				{"Math.min(pageSize, 500)", "review.c.constant", "", "  const limit = pageSize > 0 ? Math.min(pageSize, MAX_PAGE_SIZE) : DEFAULT_PAGE_SIZE;", false},
+				{".count()", "review.c.count", "{entities}", "", true},
+			},
+		},
+		{
+			title: "fix({scope}): add a timeout to {upstream} lookups",
+			lines: []string{
+				" export async function get{Entity}(id: string): Promise<{Entity}> {",
+				"-  const res = await fetch(`${API_URL}/{entities}/${id}`);",
+				"+  const res = await fetch(`${API_URL}/{entities}/${id}`, { signal: AbortSignal.timeout(2000) });",
+				"   if (!res.ok) {",
+				"     throw new ApiError(res.status, await res.text());",
+				"   }",
+				"   return res.json() as Promise<{Entity}>;",
+			},
+			comments: []reviewComment{
+				{"AbortSignal.timeout(2000)", "review.c.timeout", "{upstream}", "  const res = await fetch(`${API_URL}/{entities}/${id}`, { signal: AbortSignal.timeout(config.{upstream}TimeoutMs) });", false},
+			},
+		},
+	},
+	"cpp": {
+		{
+			title: "feat({scope}): cap page size in {Entity}Store::List",
+			lines: []string{
+				" std::vector<{Entity}> {Entity}Store::List(int pageSize, std::string_view pageToken) {",
+				"-    const int limit = pageSize;",
+				"+    const int limit = pageSize > 0 ? std::min(pageSize, 500) : 100;",
+				"     pqxx::work tx{conn_};",
+				"+    const auto total = tx.query_value<int64_t>(\"SELECT count(*) FROM {entities}\");",
+				"+    spdlog::debug(\"listing {entities}: total={} limit={}\", total, limit);",
+				"     auto rows = tx.exec_params(kList{Entities}Sql, limit, std::string{pageToken});",
+			},
+			comments: []reviewComment{
+				{"std::min(pageSize, 500)", "review.c.constant", "", "    const int limit = pageSize > 0 ? std::min(pageSize, kMaxPageSize) : kDefaultPageSize;", false},
+				{"SELECT count(*)", "review.c.count", "{entities}", "", true},
+			},
+		},
+		{
+			title: "fix({scope}): add a timeout to {upstream} lookups",
+			lines: []string{
+				" std::optional<{Entity}> {Upstream}Client::Get{Entity}(const std::string& id) {",
+				"+    http_.set_read_timeout(std::chrono::seconds{2});",
+				"     auto res = http_.Get(\"/{entities}/\" + id);",
+				"-    if (!res || res->status != 200) {",
+				"+    if (!res) {",
+				"+        spdlog::warn(\"{upstream}: GET /{entities}/{} failed: {}\", id, httplib::to_string(res.error()));",
+				"+        return std::nullopt;",
+				"+    }",
+				"+    if (res->status != 200) {",
+				"         return std::nullopt;",
+				"     }",
+			},
+			comments: []reviewComment{
+				{"seconds{2}", "review.c.timeout", "{upstream}", "    http_.set_read_timeout(config_.{upstream}Timeout);", false},
+			},
+		},
+	},
+}
+
+// 复数形式不便于直接作为实体名的服务，改用更具体的实体名
+var reviewEntities = map[string]string{
+	"inventory": "item", "billing": "invoice", "search": "document", "catalog": "product",
+	"scheduler": "job", "ingest": "event", "ledger": "entry",
+}
+
+// reviewerComment 一条已分配给评审人的评论
+type reviewerComment struct {
+	reviewComment
+	reviewer string
+}
+
+// printReviewComment 在新增行下方打印评论和建议块
+func printReviewComment(c reviewerComment, replacer *strings.Replacer) {
+	text := tr(c.key)
+	if c.subject != "" {
+		text = tr(c.key, replacer.Replace(c.subject))
+	}
+	fmt.Println(blue("   ┌ 💬 @"+c.reviewer) + " · " + text)
+	if c.suggestion != "" {
+		fmt.Println(blue("   │ ") + "```suggestion")
+		fmt.Println(blue("   │ ") + green(replacer.Replace(c.suggestion)))
+		fmt.Println(blue("   │ ") + "```")
+	}
+	fmt.Println(blue("   └"))
+	time.Sleep(time.Duration(rand.Intn(300)+200) * time.Millisecond)
+}
+
+// runCodeReview 模拟同事评审一个合并请求：带颜色的统一 diff、行内评论、建议块和评审结论
+func runCodeReview(config *SessionConfig) {
+	language := config.repo.language
+	diffs, ok := reviewDiffs[language]
+	if !ok {
+		diffs = reviewDiffs["typescript"]
+	}
+	d := diffs[rand.Intn(len(diffs))]
+
+	service := config.naming.randomService()
+	file := stackFrames(config.repo, config.naming, service)[0]
+	upstream := config.naming.randomService()
+	if upstream == service {
+		upstream = "auth"
+	}
+	entity, ok := reviewEntities[service]
+	if !ok {
+		entity = singular(service)
+	}
+	entities := entity + "s"
+	if strings.HasSuffix(entity, "y") {
+		entities = strings.TrimSuffix(entity, "y") + "ies"
+	}
+	replacer := strings.NewReplacer(
+		"{Entity}", exportedName(entity), "{entity}", entity,
+		"{Entities}", exportedName(entities), "{entities}", entities, "{ENTITIES}", strings.ToUpper(entities),
+		"{Upstream}", exportedName(upstream), "{upstream}", upstream, "{scope}", service)
+
+	// 评审同事新开的合并请求，标题来自所选的 diff 模板
+	order := rand.Perm(len(teammates))
+	g := config.git
+	author := teammates[order[0]]
+	pr := pullRequest{number: g.nextPR, title: replacer.Replace(d.title)}
+	g.nextPR++
+	reviewers := make([]string, 0, 2)
+	for _, i := range order[1 : rand.Intn(2)+2] {
+		reviewers = append(reviewers, teammates[i])
+	}
+	fmt.Println(blue("👀 " + tr("review.title", pr.number, pr.title, "@"+author)))
+
+	// 评论随机保留一部分，分给不同的评审人
+	var comments []reviewerComment
+	for _, c := range d.comments {
+		if rand.Float32() < 0.8 {
+			comments = append(comments, reviewerComment{c, reviewers[rand.Intn(len(reviewers))]})
+		}
+	}
+
+	removed, added := 0, 0
+	for _, l := range d.lines {
+		switch l[0] {
+		case '-':
+			removed++
+		case '+':
+			added++
+		}
+	}
+	oldStart := rand.Intn(max(file.lines-40, 1)) + 12
+	newStart := oldStart + rand.Intn(5)
+	fmt.Printf("diff --git a/%s b/%s\n", file.path, file.path)
+	fmt.Printf("index %s..%s 100644\n", randomHex(7), randomHex(7))
+	fmt.Printf("--- a/%s\n+++ b/%s\n", file.path, file.path)
+	fmt.Println(blue(fmt.Sprintf("@@ -%d,%d +%d,%d @@", oldStart, len(d.lines)-added, newStart, len(d.lines)-removed)))
+	for _, l := range d.lines {
+		line := replacer.Replace(l)
+		switch line[0] {
+		case '-':
+			fmt.Println(red(line))
+		case '+':
+			fmt.Println(green(line))
+		default:
+			fmt.Println(line)
+		}
+		time.Sleep(time.Duration(rand.Intn(60)+30) * time.Millisecond)
+		if line[0] != '+' {
+			continue
+		}
+		for _, c := range comments {
+			if strings.Contains(l, c.anchor) {
+				printReviewComment(c, replacer)
+			}
+		}
+	}
+	fmt.Println(diffSummary(1, added, removed))
+
+	// 每个评审人给出结论：有阻塞性评论则要求修改，否则批准
+	fmt.Println()
+	suggestions := 0
+	for _, c := range comments {
+		if c.suggestion != "" {
+			suggestions++
+		}
+	}
+	changesRequested := false
+	for _, r := range reviewers {
+		blocking := false
+		for _, c := range comments {
+			if c.reviewer == r && c.blocking {
+				blocking = true
+			}
+		}
+		if blocking {
+			changesRequested = true
+			fmt.Println(red("🔁 " + tr("review.changes", "@"+r)))
+		} else {
+			fmt.Println(green("✅ " + tr("review.approved", "@"+r)))
+		}
+	}
+	summary := tr("review.summary", trn("review.comments", len(comments)), trn("review.suggestions", suggestions))
+	if changesRequested {
+		fmt.Printf("📝 %s\n", summary)
+	} else {
+		fmt.Printf("🚀 %s\n", summary)
+	}
+	if rand.Float32() < jargonDensity(config.jargonLevel) {
+		fmt.Printf("🧠 %s\n", tr("jargon.insight", generateCodeJargon(config.devType, config.jargonLevel)))
+	}
+}
@@ -0,0 +1,448 @@
+package main
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// tfComputed 表示创建后才能得知的属性值
+const tfComputed = "(known after apply)"
+
+// tfResourceKind 一种云资源及其属性模板
+//
+// 模板中的占位符：{name} 服务全名，{svc} 服务短名，{svcalnum} 只含字母数字的服务名，
+// {db} 数据库名，{project} 项目名，{storage} 存储账号名，{region} 区域，{account} 账号/项目 ID，
+// {hex}/{HEX} 随机十六进制串，{octet} 随机 IP 段
+type tfResourceKind struct {
+	typ     string
+	seconds [2]int      // 创建耗时范围（秒）
+	attrs   [][2]string // 创建时展示的属性
+	id      string
+	update  [3]string // 原地更新的属性：名称、旧值、新值
+	output  [2]string // 可选的输出：名称、值
+}
+
+// cloudProvider 一个云厂商的资源目录
+type cloudProvider struct {
+	name      string
+	source    string // registry 地址
+	version   string
+	region    string
+	resources []tfResourceKind
+}
+
+// 云厂商资源目录
+var cloudProviders = []cloudProvider{
+	{
+		name: "aws", source: "hashicorp/aws", version: "5.61.0", region: "us-east-1",
+		resources: []tfResourceKind{
+			{
+				typ: "aws_db_instance", seconds: [2]int{240, 540},
+				attrs: [][2]string{
+					{"identifier", "{name}"}, {"engine", "postgres"}, {"engine_version", "16.3"},
+					{"instance_class", "db.r6g.large"}, {"allocated_storage", "100"}, {"db_name", "{db}"},
+					{"multi_az", "true"}, {"arn", tfComputed}, {"endpoint", tfComputed},
+				},
+				id:     "db-{HEX}",
+				update: [3]string{"instance_class", "db.r6g.large", "db.r6g.xlarge"},
+				output: [2]string{"{svc}_db_endpoint", "{name}.c{hex}.{region}.rds.amazonaws.com:5432"},
+			},
+			{
+				typ: "aws_ecs_service", seconds: [2]int{5, 45},
+				attrs: [][2]string{
+					{"name", "{name}"}, {"cluster", "{project}"}, {"desired_count", "3"},
+					{"launch_type", "FARGATE"}, {"id", tfComputed},
+				},
+				id:     "arn:aws:ecs:{region}:{account}:service/{project}/{name}",
+				update: [3]string{"desired_count", "3", "5"},
+			},
+			{
+				typ: "aws_sqs_queue", seconds: [2]int{1, 4},
+				attrs: [][2]string{
+					{"name", "{name}-events"}, {"visibility_timeout_seconds", "30"},
+					{"message_retention_seconds", "345600"}, {"url", tfComputed}, {"arn", tfComputed},
+				},
+				id:     "https://sqs.{region}.amazonaws.com/{account}/{name}-events",
+				update: [3]string{"visibility_timeout_seconds", "30", "120"},
+				output: [2]string{"{svc}_queue_url", "https://sqs.{region}.amazonaws.com/{account}/{name}-events"},
+			},
+			{
+				typ: "aws_elasticache_replication_group", seconds: [2]int{300, 720},
+				attrs: [][2]string{
+					{"replication_group_id", "{name}-cache"}, {"engine", "redis"}, {"node_type", "cache.r7g.large"},
+					{"num_cache_clusters", "2"}, {"automatic_failover_enabled", "true"},
+					{"primary_endpoint_address", tfComputed},
+				},
+				id:     "{name}-cache",
+				update: [3]string{"node_type", "cache.r7g.large", "cache.r7g.xlarge"},
+				output: [2]string{"{svc}_redis_endpoint", "master.{name}-cache.{hex}.use1.cache.amazonaws.com"},
+			},
+			{
+				typ: "aws_s3_bucket", seconds: [2]int{1, 3},
+				attrs: [][2]string{
+					{"bucket", "{name}-artifacts"}, {"force_destroy", "false"},
+					{"arn", tfComputed}, {"bucket_domain_name", tfComputed},
+				},
+				id:     "{name}-artifacts",
+				update: [3]string{"force_destroy", "false", "true"},
+			},
+			{
+				typ: "aws_iam_role", seconds: [2]int{1, 2},
+				attrs: [][2]string{
+					{"name", "{name}-task"}, {"max_session_duration", "3600"}, {"path", "/"},
+					{"arn", tfComputed}, {"unique_id", tfComputed},
+				},
+				id:     "{name}-task",
+				update: [3]string{"max_session_duration", "3600", "7200"},
+			},
+		},
+	},
+	{
+		name: "google", source: "hashicorp/google", version: "5.40.0", region: "europe-west1",
+		resources: []tfResourceKind{
+			{
+				typ: "google_sql_database_instance", seconds: [2]int{300, 840},
+				attrs: [][2]string{
+					{"name", "{name}-pg"}, {"database_version", "POSTGRES_16"}, {"region", "{region}"},
+					{"deletion_protection", "true"}, {"connection_name", tfComputed}, {"self_link", tfComputed},
+				},
+				id:     "{name}-pg",
+				update: [3]string{"deletion_protection", "false", "true"},
+				output: [2]string{"{svc}_connection_name", "{account}:{region}:{name}-pg"},
+			},
+			{
+				typ: "google_cloud_run_v2_service", seconds: [2]int{15, 60},
+				attrs: [][2]string{
+					{"name", "{name}"}, {"location", "{region}"}, {"ingress", "INGRESS_TRAFFIC_ALL"},
+					{"uri", tfComputed}, {"uid", tfComputed},
+				},
+				id:     "projects/{account}/locations/{region}/services/{name}",
+				update: [3]string{"ingress", "INGRESS_TRAFFIC_ALL", "INGRESS_TRAFFIC_INTERNAL_LOAD_BALANCER"},
+				output: [2]string{"{svc}_url", "https://{name}-{hex}-ew.a.run.app"},
+			},
+			{
+				typ: "google_pubsub_topic", seconds: [2]int{2, 6},
+				attrs: [][2]string{
+					{"name", "{name}-events"}, {"message_retention_duration", "86400s"}, {"id", tfComputed},
+				},
+				id:     "projects/{account}/topics/{name}-events",
+				update: [3]string{"message_retention_duration", "86400s", "604800s"},
+			},
+			{
+				typ: "google_redis_instance", seconds: [2]int{240, 600},
+				attrs: [][2]string{
+					{"name", "{name}-cache"}, {"tier", "STANDARD_HA"}, {"memory_size_gb", "5"},
+					{"region", "{region}"}, {"host", tfComputed}, {"port", tfComputed},
+				},
+				id:     "projects/{account}/locations/{region}/instances/{name}-cache",
+				update: [3]string{"memory_size_gb", "5", "10"},
+				output: [2]string{"{svc}_redis_host", "10.{octet}.16.4"},
+			},
+			{
+				typ: "google_storage_bucket", seconds: [2]int{1, 3},
+				attrs: [][2]string{
+					{"name", "{name}-artifacts"}, {"location", "EU"}, {"uniform_bucket_level_access", "true"},
+					{"url", tfComputed}, {"self_link", tfComputed},
+				},
+				id:     "{name}-artifacts",
+				update: [3]string{"uniform_bucket_level_access", "false", "true"},
+			},
+			{
+				typ: "google_service_account", seconds: [2]int{1, 3},
+				attrs: [][2]string{
+					{"account_id", "{svc}-runtime"}, {"display_name", "{svc} runtime"},
+					{"email", tfComputed}, {"unique_id", tfComputed},
+				},
+				id:     "projects/{account}/serviceAccounts/{svc}-runtime@{account}.iam.gserviceaccount.com",
+				update: [3]string{"display_name", "{svc} runtime", "{svc} runtime (managed by terraform)"},
+			},
+		},
+	},
+	{
+		name: "azurerm", source: "hashicorp/azurerm", version: "3.114.0", region: "westeurope",
+		resources: []tfResourceKind{
+			{
+				typ: "azurerm_postgresql_flexible_server", seconds: [2]int{300, 900},
+				attrs: [][2]string{
+					{"name", "{name}-psql"}, {"location", "{region}"}, {"sku_name", "GP_Standard_D4s_v3"},
+					{"version", "16"}, {"storage_mb", "131072"}, {"fqdn", tfComputed}, {"id", tfComputed},
+				},
+				id:     "/subscriptions/{account}/resourceGroups/{project}-rg/providers/Microsoft.DBforPostgreSQL/flexibleServers/{name}-psql",
+				update: [3]string{"sku_name", "GP_Standard_D4s_v3", "GP_Standard_D8s_v3"},
+				output: [2]string{"{svc}_db_fqdn", "{name}-psql.postgres.database.azure.com"},
+			},
+			{
+				typ: "azurerm_kubernetes_cluster_node_pool", seconds: [2]int{180, 420},
+				attrs: [][2]string{
+					{"name", "{svcalnum}"}, {"vm_size", "Standard_D4s_v5"}, {"node_count", "3"},
+					{"mode", "User"}, {"id", tfComputed},
+				},
+				id:     "/subscriptions/{account}/resourceGroups/{project}-rg/providers/Microsoft.ContainerService/managedClusters/{project}-aks/agentPools/{svcalnum}",
+				update: [3]string{"node_count", "3", "5"},
+			},
+			{
+				typ: "azurerm_servicebus_queue", seconds: [2]int{2, 8},
+				attrs: [][2]string{
+					{"name", "{svc}-events"}, {"max_delivery_count", "10"}, {"lock_duration", "PT1M"},
+					{"id", tfComputed},
+				},
+				id:     "/subscriptions/{account}/resourceGroups/{project}-rg/providers/Microsoft.ServiceBus/namespaces/{project}-sb/queues/{svc}-events",
+				update: [3]string{"max_delivery_count", "10", "20"},
+			},
+			{
+				typ: "azurerm_redis_cache", seconds: [2]int{600, 1200},
+				attrs: [][2]string{
+					{"name", "{name}-redis"}, {"location", "{region}"}, {"family", "C"}, {"capacity", "2"},
+					{"sku_name", "Standard"}, {"hostname", tfComputed}, {"primary_access_key", "(sensitive value)"},
+				},
+				id:     "/subscriptions/{account}/resourceGroups/{project}-rg/providers/Microsoft.Cache/redis/{name}-redis",
+				update: [3]string{"capacity", "2", "3"},
+				output: [2]string{"{svc}_redis_hostname", "{name}-redis.redis.cache.windows.net"},
+			},
+			{
+				typ: "azurerm_storage_account", seconds: [2]int{20, 40},
+				attrs: [][2]string{
+					{"name", "{storage}"}, {"account_tier", "Standard"}, {"account_replication_type", "ZRS"},
+					{"min_tls_version", "TLS1_2"}, {"primary_blob_endpoint", tfComputed},
+				},
+				id:     "/subscriptions/{account}/resourceGroups/{project}-rg/providers/Microsoft.Storage/storageAccounts/{storage}",
+				update: [3]string{"min_tls_version", "TLS1_0", "TLS1_2"},
+			},
+			{
+				typ: "azurerm_user_assigned_identity", seconds: [2]int{1, 4},
+				attrs: [][2]string{
+					{"name", "{name}-id"}, {"location", "{region}"}, {"client_id", tfComputed}, {"principal_id", tfComputed},
+				},
+				id:     "/subscriptions/{account}/resourceGroups/{project}-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/{name}-id",
+				update: [3]string{"tags.team", "platform", "{svc}"},
+			},
+		},
+	},
+}
+
+// cloudProviderFor 根据项目名选择云厂商，同一项目总是使用同一家
+func cloudProviderFor(naming *ProjectNaming) cloudProvider {
+	h := fnv.New32a()
+	h.Write([]byte(naming.name))
+	return cloudProviders[h.Sum32()%uint32(len(cloudProviders))]
+}
+
+// cloudAccount 生成云账号 ID：AWS 为 12 位数字，GCP 为项目 ID，Azure 为订阅 UUID
+func cloudAccount(provider string, naming *ProjectNaming) string {
+	h := fnv.New64a()
+	h.Write([]byte(naming.name))
+	sum := h.Sum64()
+	switch provider {
+	case "google":
+		return fmt.Sprintf("%s-%06d", naming.name, sum%1000000)
+	case "azurerm":
+		x := fmt.Sprintf("%016x%016x", sum, sum*2654435761)
+		return fmt.Sprintf("%s-%s-%s-%s-%s", x[0:8], x[8:12], x[12:16], x[16:20], x[20:32])
+	}
+	return fmt.Sprintf("%012d", sum%1000000000000)
+}
+
+// tfChange 计划中的一项资源变更
+type tfChange struct {
+	kind    tfResourceKind
+	address string
+	action  string // create、update、destroy
+	expand  func(string) string
+}
+
+// tfValue 按 HCL 的写法格式化属性值
+func tfValue(v string) string {
+	if v == tfComputed || v == "(sensitive value)" || v == "true" || v == "false" {
+		return v
+	}
+	if _, err := strconv.Atoi(v); err == nil {
+		return v
+	}
+	return strconv.Quote(v)
+}
+
+// tfDuration 按 terraform 的格式显示耗时，如 4m12s
+func tfDuration(seconds int) string {
+	if seconds < 60 {
+		return fmt.Sprintf("%ds", seconds)
+	}
+	return fmt.Sprintf("%dm%ds", seconds/60, seconds%60)
+}
+
+// planInfrastructure 随机选出本次要创建、修改和销毁的资源
+func planInfrastructure(provider cloudProvider, naming *ProjectNaming) []tfChange {
+	account := cloudAccount(provider.name, naming)
+	actions := []string{"create", "create"}
+	for i := rand.Intn(3); i > 0; i-- {
+		actions = append(actions, "create")
+	}
+	for i := rand.Intn(3); i > 0; i-- {
+		actions = append(actions, "update")
+	}
+	if rand.Float32() < 0.4 {
+		actions = append(actions, "destroy")
+	}
+
+	var changes []tfChange
+	used := map[string]bool{}
+	for _, action := range actions {
+		kind := provider.resources[rand.Intn(len(provider.resources))]
+		svc := naming.randomService()
+		local := strings.ReplaceAll(svc, "-", "_")
+		address := kind.typ + "." + local
+		if used[address] {
+			continue
+		}
+		used[address] = true
+
+		alnum := strings.ReplaceAll(svc, "-", "")
+		storage := naming.pkgName + alnum
+		if len(storage) > 22 {
+			storage = storage[:22]
+		}
+		hex := randomHex(10)
+		expand := strings.NewReplacer(
+			"{name}", naming.serviceName(svc),
+			"{svcalnum}", alnum[:min(len(alnum), 12)],
+			"{svc}", svc,
+			"{db}", naming.databaseName(svc),
+			"{project}", naming.name,
+			"{storage}", storage+"sa",
+			"{region}", provider.region,
+			"{account}", account,
+			"{hex}", hex,
+			"{HEX}", strings.ToUpper(hex),
+			"{octet}", strconv.Itoa(rand.Intn(250)+1),
+		).Replace
+		changes = append(changes, tfChange{kind: kind, address: address, action: action, expand: expand})
+	}
+	return changes
+}
+
+// printPlanChange 打印一项资源变更的 plan 输出
+func printPlanChange(c tfChange) {
+	local := c.address[strings.Index(c.address, ".")+1:]
+	switch c.action {
+	case "create":
+		fmt.Printf("  # %s will be created\n", c.address)
+		fmt.Printf("  %s resource %q %q {\n", green("+"), c.kind.typ, local)
+		attrs := append([][2]string(nil), c.kind.attrs...)
+		sort.Slice(attrs, func(i, j int) bool { return attrs[i][0] < attrs[j][0] })
+		width := 0
+		for _, a := range attrs {
+			width = max(width, len(a[0]))
+		}
+		for _, a := range attrs {
+			fmt.Printf("      %s %-*s = %s\n", green("+"), width, a[0], tfValue(c.expand(a[1])))
+		}
+	case "update":
+		key, old, updated := c.kind.update[0], c.expand(c.kind.update[1]), c.expand(c.kind.update[2])
+		width := max(len(key), len("id"))
+		fmt.Printf("  # %s will be updated in-place\n", c.address)
+		fmt.Printf("  %s resource %q %q {\n", yellow("~"), c.kind.typ, local)
+		fmt.Printf("        %-*s = %s\n", width, "id", tfValue(c.expand(c.kind.id)))
+		fmt.Printf("      %s %-*s = %s -> %s\n", yellow("~"), width, key, tfValue(old), tfValue(updated))
+		fmt.Printf("        # (%d unchanged attributes hidden)\n", rand.Intn(20)+4)
+	case "destroy":
+		fmt.Printf("  # %s will be destroyed\n", c.address)
+		fmt.Printf("  %s resource %q %q {\n", red("-"), c.kind.typ, local)
+		fmt.Printf("      %s id = %s -> null\n", red("-"), tfValue(c.expand(c.kind.id)))
+		fmt.Printf("        # (%d unchanged attributes hidden)\n", rand.Intn(20)+4)
+	}
+	fmt.Println("    }")
+	fmt.Println()
+	time.Sleep(time.Duration(rand.Intn(200)+100) * time.Millisecond)
+}
+
+// runTerraform 模拟 terraform plan 和 apply
+func runTerraform(config *SessionConfig) {
+	provider := cloudProviderFor(config.naming)
+	fmt.Println(blue("🏗️  " + tr("tf.title", provider.name, provider.region)))
+
+	changes := planInfrastructure(provider, config.naming)
+	fmt.Println("$ terraform plan -out=tfplan")
+	for _, c := range changes {
+		if c.action != "create" {
+			fmt.Printf("%s: Refreshing state... [id=%s]\n", c.address, c.expand(c.kind.id))
+		}
+	}
+	fmt.Println()
+	fmt.Println("Terraform used the selected providers to generate the following execution")
+	fmt.Println("plan. Resource actions are indicated with the following symbols:")
+	fmt.Printf("  %s create\n", green("+"))
+	fmt.Printf("  %s update in-place\n", yellow("~"))
+	fmt.Printf("  %s destroy\n", red("-"))
+	fmt.Println()
+	fmt.Println("Terraform will perform the following actions:")
+	fmt.Println()
+
+	add, change, destroy := 0, 0, 0
+	var outputs [][2]string
+	for _, c := range changes {
+		printPlanChange(c)
+		switch c.action {
+		case "create":
+			add++
+			if c.kind.output[0] != "" {
+				outputs = append(outputs, [2]string{c.expand(c.kind.output[0]), c.expand(c.kind.output[1])})
+			}
+		case "update":
+			change++
+		case "destroy":
+			destroy++
+		}
+	}
+	fmt.Printf("Plan: %d to add, %d to change, %d to destroy.\n", add, change, destroy)
+	if len(outputs) > 0 {
+		fmt.Println("\nChanges to Outputs:")
+		for _, o := range outputs {
+			fmt.Printf("  %s %s = %s\n", green("+"), o[0], tfComputed)
+		}
+	}
+
+	// apply，按照 terraform 的并发输出模拟每个资源的计时
+	fmt.Println("\n$ terraform apply tfplan")
+	for _, c := range changes {
+		id := c.expand(c.kind.id)
+		seconds := c.kind.seconds[0] + rand.Intn(c.kind.seconds[1]-c.kind.seconds[0]+1)
+		switch c.action {
+		case "create":
+			fmt.Printf("%s: Creating...\n", c.address)
+			for elapsed := 10; elapsed < seconds && elapsed <= 30; elapsed += 10 {
+				time.Sleep(time.Duration(rand.Intn(200)+150) * time.Millisecond)
+				fmt.Printf("%s: Still creating... [%s elapsed]\n", c.address, tfDuration(elapsed))
+			}
+			if seconds > 40 {
+				fmt.Printf("%s: Still creating... [%s elapsed]\n", c.address, tfDuration(seconds/10*10))
+			}
+			fmt.Printf("%s: Creation complete after %s [id=%s]\n", c.address, tfDuration(seconds), id)
+		case "update":
+			seconds = min(seconds, rand.Intn(20)+2)
+			fmt.Printf("%s: Modifying... [id=%s]\n", c.address, id)
+			time.Sleep(time.Duration(rand.Intn(200)+100) * time.Millisecond)
+			fmt.Printf("%s: Modifications complete after %s [id=%s]\n", c.address, tfDuration(seconds), id)
+		case "destroy":
+			fmt.Printf("%s: Destroying... [id=%s]\n", c.address, id)
+			time.Sleep(time.Duration(rand.Intn(200)+100) * time.Millisecond)
+			fmt.Printf("%s: Destruction complete after %s\n", c.address, tfDuration(max(1, seconds/3)))
+		}
+	}
+	fmt.Println()
+	fmt.Println(green(fmt.Sprintf("Apply complete! Resources: %d added, %d changed, %d destroyed.", add, change, destroy)))
+	if len(outputs) > 0 {
+		fmt.Print("\nOutputs:\n\n")
+		for _, o := range outputs {
+			fmt.Printf("%s = %q\n", o[0], o[1])
+		}
+	}
+
+	fmt.Printf("\n☁️  %s\n", tr("tf.summary", provider.source, provider.version, add, change, destroy))
+	if rand.Float32() < jargonDensity(config.jargonLevel) {
+		fmt.Printf("🧠 %s\n", tr("jargon.insight", generateJargon(config.devType, config.jargonLevel)))
+	}
+}
@@ -0,0 +1,560 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// 测试结果
+const (
+	outcomePass  = "pass"
+	outcomeFail  = "fail"
+	outcomeSkip  = "skip"
+	outcomeFlaky = "flaky" // 第一次失败，重试后通过
+)
+
+// testCase 一个测试用例
+type testCase struct {
+	behavior testBehavior
+	subtests []testBehavior
+	outcome  string
+	duration float64
+}
+
+// testSuite 一个测试文件及其覆盖的包
+type testSuite struct {
+	file     RepoFile
+	subject  string // 被测对象名，如 OrderHandler、Dashboard
+	cases    []testCase
+	coverage float64
+	duration float64
+}
+
+// testBehavior 测试行为，同时提供不同语言的命名形式
+type testBehavior struct {
+	snake    string // get_by_id
+	sentence string // returns the record by id
+}
+
+// 测试行为候选
+var testBehaviors = []testBehavior{
+	{"create", "creates a record"},
+	{"get_by_id", "returns the record by id"},
+	{"list_paginated", "lists records with pagination"},
+	{"update_conflict", "rejects a conflicting update"},
+	{"delete_missing", "handles deleting a missing record"},
+	{"validates_input", "validates input"},
+	{"handles_timeout", "handles upstream timeouts"},
+	{"retries_on_unavailable", "retries when the dependency is unavailable"},
+	{"rejects_unauthorized", "rejects unauthorized callers"},
+	{"serializes_roundtrip", "round-trips through serialization"},
+	{"concurrent_access", "is safe under concurrent access"},
+	{"empty_state", "renders the empty state"},
+}
+
+// 子测试候选
+var subtestNames = []testBehavior{
+	{"valid_payload", "with a valid payload"},
+	{"missing_field", "with a missing field"},
+	{"zero_value", "with zero values"},
+	{"max_length", "at the maximum length"},
+	{"unicode_input", "with unicode input"},
+}
+
+// 失败信息候选，%s 为被测对象
+var testFailureMessages = []string{
+	"expected status 200, got 503",
+	"context deadline exceeded while waiting for %s",
+	"expected 3 items, got 2",
+	"connection refused (dial tcp 127.0.0.1:5432)",
+	"assertion failed: %s state mismatch",
+}
+
+// camelCase 将 snake_case 转换为 CamelCase，保留 ID 之类的缩写
+func camelCase(snake string) string {
+	parts := strings.Split(snake, "_")
+	for i, p := range parts {
+		if p == "id" {
+			parts[i] = "ID"
+		} else {
+			parts[i] = exportedName(p)
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+// lowerCamelCase 将 snake_case 转换为首字母小写的 camelCase
+func lowerCamelCase(snake string) string {
+	return lowerFirst(camelCase(snake))
+}
+
+// testSubject 根据测试文件推导被测对象名
+func testSubject(f RepoFile) string {
+	base := path.Base(f.path)
+	for _, suffix := range []string{"_test.go", ".test.ts", ".spec.ts", ".t.sol", "_it.rs", "Test.java", "Tests.cpp", ".py"} {
+		base = strings.TrimSuffix(base, suffix)
+	}
+	base = strings.TrimPrefix(base, "test_")
+	base = strings.TrimSuffix(base, ".service")
+
+	if f.language == "go" {
+		// internal/orders/handler_test.go -> OrderHandler，pkg/client/client_test.go -> Client
+		entity := exportedName(singular(path.Base(f.dir)))
+		if name := camelCase(base); name != entity {
+			return entity + name
+		}
+		return entity
+	}
+	return base
+}
+
+// planTests 根据虚拟仓库中的测试文件生成本次运行的测试计划
+func planTests(repo *FakeRepo) []testSuite {
+	var files []RepoFile
+	for _, f := range repo.testFiles() {
+		// 合约测试由 forge 运行，不属于主测试命令
+		if f.language != "solidity" {
+			files = append(files, f)
+		}
+	}
+	rand.Shuffle(len(files), func(i, j int) { files[i], files[j] = files[j], files[i] })
+	if len(files) > 6 {
+		files = files[:rand.Intn(3)+4]
+	}
+
+	var suites []testSuite
+	for _, f := range files {
+		suite := testSuite{file: f, subject: testSubject(f), coverage: rand.Float64()*35 + 60}
+		for _, i := range rand.Perm(len(testBehaviors))[:rand.Intn(3)+2] {
+			tc := testCase{behavior: testBehaviors[i], outcome: outcomePass, duration: rand.Float64() * 0.05}
+			switch r := rand.Float32(); {
+			case r < 0.07:
+				tc.outcome = outcomeSkip
+				tc.duration = 0
+			case r < 0.13:
+				tc.outcome = outcomeFlaky
+			case r < 0.15:
+				tc.outcome = outcomeFail
+			}
+			if rand.Float32() < 0.3 {
+				for _, j := range rand.Perm(len(subtestNames))[:rand.Intn(2)+2] {
+					tc.subtests = append(tc.subtests, subtestNames[j])
+				}
+			}
+			if rand.Float32() < 0.1 {
+				tc.duration += rand.Float64() * 1.5
+			}
+			suite.duration += tc.duration
+			suite.cases = append(suite.cases, tc)
+		}
+		suite.duration += rand.Float64() * 0.3
+		suites = append(suites, suite)
+	}
+	return suites
+}
+
+// testTotals 汇总测试结果
+type testTotals struct {
+	passed, failed, skipped, flaky int
+	duration                       float64
+	coverage                       float64
+}
+
+func summarizeTests(suites []testSuite) testTotals {
+	var t testTotals
+	for _, s := range suites {
+		for _, tc := range s.cases {
+			switch tc.outcome {
+			case outcomePass:
+				t.passed++
+			case outcomeFail:
+				t.failed++
+			case outcomeSkip:
+				t.skipped++
+			case outcomeFlaky:
+				t.flaky++
+			}
+		}
+		t.duration += s.duration
+		t.coverage += s.coverage
+	}
+	if len(suites) > 0 {
+		t.coverage /= float64(len(suites))
+	}
+	return t
+}
+
+// testRunnerName 返回仓库语言对应的测试命令
+func testRunnerName(language string) string {
+	switch language {
+	case "rust":
+		return "cargo test"
+	case "typescript", "vue":
+		return "vitest"
+	case "python":
+		return "pytest"
+	case "java":
+		return "gradle test"
+	case "cpp":
+		return "ctest"
+	}
+	return "go test"
+}
+
+// runTests 模拟运行测试套件
+func runTests(config *SessionConfig) {
+	runner := testRunnerName(config.repo.language)
+	fmt.Println(green("🧪 " + tr("tests.title", runner)))
+
+	suites := planTests(config.repo)
+	switch runner {
+	case "cargo test":
+		runCargoTests(config, suites)
+	case "vitest":
+		runVitest(config, suites)
+	case "pytest":
+		runPytest(config, suites)
+	case "gradle test":
+		runGradleTests(suites)
+	case "ctest":
+		runCTest(suites)
+	default:
+		runGoTests(config, suites)
+	}
+
+	t := summarizeTests(suites)
+	fmt.Printf("\n📊 %s\n", tr("tests.summary", formatInt(t.passed+t.flaky), formatInt(t.failed), formatInt(t.skipped),
+		formatInt(t.flaky), formatPercentFloat(t.coverage, 1)))
+	if t.failed > 0 {
+		fmt.Printf("  %s\n", red(tr("tests.failedHint")))
+	}
+}
+
+// testPause 测试输出之间的短暂停顿
+func testPause() {
+	time.Sleep(time.Duration(rand.Intn(90)+30) * time.Millisecond)
+}
+
+// failureMessage 生成一条失败信息
+func failureMessage(subject string) string {
+	msg := testFailureMessages[rand.Intn(len(testFailureMessages))]
+	if strings.Contains(msg, "%s") {
+		return fmt.Sprintf(msg, subject)
+	}
+	return msg
+}
+
+func runGoTests(config *SessionConfig, suites []testSuite) {
+	fmt.Println("$ go test -v -cover ./...")
+	// 同一个包的测试文件在一次 go test 中输出
+	sort.SliceStable(suites, func(i, j int) bool { return suites[i].file.dir < suites[j].file.dir })
+	var retry []string
+	pkgFailed := false
+	pkgDuration, pkgCoverage, pkgFiles := 0.0, 0.0, 0
+	for i, s := range suites {
+		for _, tc := range s.cases {
+			name := fmt.Sprintf("Test%s_%s", s.subject, camelCase(tc.behavior.snake))
+			fmt.Printf("=== RUN   %s\n", name)
+			for _, sub := range tc.subtests {
+				fmt.Printf("=== RUN   %s/%s\n", name, sub.snake)
+			}
+			testPause()
+			switch tc.outcome {
+			case outcomeSkip:
+				fmt.Printf("    %s:%d: skipping integration test in short mode\n", path.Base(s.file.path), rand.Intn(s.file.lines)+1)
+				fmt.Printf("--- %s: %s (0.00s)\n", yellow("SKIP"), name)
+			case outcomeFail, outcomeFlaky:
+				pkgFailed = true
+				retry = append(retry, name)
+				fmt.Printf("    %s:%d: %s\n", path.Base(s.file.path), rand.Intn(s.file.lines)+1, failureMessage(s.subject))
+				fmt.Printf("--- %s: %s (%.2fs)\n", red("FAIL"), name, tc.duration)
+			default:
+				fmt.Printf("--- %s: %s (%.2fs)\n", green("PASS"), name, tc.duration)
+				for _, sub := range tc.subtests {
+					fmt.Printf("    --- %s: %s/%s (%.2fs)\n", green("PASS"), name, sub.snake, tc.duration/float64(len(tc.subtests)))
+				}
+			}
+		}
+		pkgDuration += s.duration
+		pkgCoverage += s.coverage
+		pkgFiles++
+		if i+1 < len(suites) && suites[i+1].file.dir == s.file.dir {
+			continue
+		}
+
+		pkg := config.naming.modulePath + "/" + s.file.dir
+		coverage := pkgCoverage / float64(pkgFiles)
+		if pkgFailed {
+			fmt.Println(red("FAIL"))
+			fmt.Printf("%s\t%s\t%.3fs\n", red("FAIL"), pkg, pkgDuration)
+		} else {
+			fmt.Println(green("PASS"))
+			fmt.Printf("coverage: %.1f%% of statements\n", coverage)
+			fmt.Printf("ok  \t%s\t%.3fs\tcoverage: %.1f%% of statements\n", pkg, pkgDuration, coverage)
+		}
+		pkgFailed = false
+		pkgDuration, pkgCoverage, pkgFiles = 0, 0, 0
+	}
+
+	if len(retry) == 0 {
+		return
+	}
+	// 失败的测试单独重跑一次，用于识别不稳定测试
+	fmt.Printf("\n🔁 %s\n", trn("tests.retrying", len(retry)))
+	fmt.Printf("$ go test -count=1 -run '^(%s)$' ./...\n", strings.Join(retry, "|"))
+	for _, s := range suites {
+		for _, tc := range s.cases {
+			name := fmt.Sprintf("Test%s_%s", s.subject, camelCase(tc.behavior.snake))
+			switch tc.outcome {
+			case outcomeFlaky:
+				fmt.Printf("--- %s: %s (%.2fs)\n", green("PASS"), name, tc.duration)
+			case outcomeFail:
+				fmt.Printf("--- %s: %s (%.2fs)\n", red("FAIL"), name, tc.duration)
+			}
+		}
+	}
+}
+
+func runCargoTests(config *SessionConfig, suites []testSuite) {
+	fmt.Println("$ cargo test --workspace")
+	retry := 0
+	for _, s := range suites {
+		fmt.Printf("     %s tests/%s (target/debug/deps/%s-%s)\n\n", green("Running"), path.Base(s.file.path),
+			strings.TrimSuffix(path.Base(s.file.path), ".rs"), randomHex(16))
+		fmt.Printf("running %d tests\n", len(s.cases))
+		passed, failed, ignored := 0, 0, 0
+		for _, tc := range s.cases {
+			name := s.subject + "_" + tc.behavior.snake
+			testPause()
+			switch tc.outcome {
+			case outcomeSkip:
+				ignored++
+				fmt.Printf("test %s ... %s\n", name, yellow("ignored"))
+			case outcomeFail, outcomeFlaky:
+				failed++
+				retry++
+				fmt.Printf("test %s ... %s\n", name, red("FAILED"))
+			default:
+				passed++
+				fmt.Printf("test %s ... %s\n", name, green("ok"))
+			}
+		}
+		status := green("ok")
+		if failed > 0 {
+			status = red("FAILED")
+		}
+		fmt.Printf("\ntest result: %s. %d passed; %d failed; %d ignored; 0 measured; 0 filtered out; finished in %.2fs\n\n",
+			status, passed, failed, ignored, s.duration)
+	}
+
+	// cargo llvm-cov 风格的覆盖率汇总
+	fmt.Println("Filename                                              Regions    Cover")
+	for _, s := range suites {
+		fmt.Printf("%-52s %8d  %6.2f%%\n", strings.TrimSuffix(s.file.dir, "/tests")+"/src/lib.rs", rand.Intn(400)+50, s.coverage)
+	}
+
+	if retry > 0 {
+		fmt.Printf("\n🔁 %s\n", trn("tests.retrying", retry))
+		for _, s := range suites {
+			for _, tc := range s.cases {
+				name := s.subject + "_" + tc.behavior.snake
+				switch tc.outcome {
+				case outcomeFlaky:
+					fmt.Printf("test %s ... %s\n", name, green("ok"))
+				case outcomeFail:
+					fmt.Printf("test %s ... %s\n", name, red("FAILED"))
+				}
+			}
+		}
+	}
+}
+
+func runVitest(config *SessionConfig, suites []testSuite) {
+	fmt.Println("$ npx vitest run --reporter=verbose --retry=1 --coverage")
+	fmt.Printf("\n %s %s %s\n", blue("RUN"), blue("v2.0.4"), config.naming.repoDir)
+	fmt.Printf("      Coverage enabled with %s\n\n", yellow("v8"))
+	total, failedSuites := 0, 0
+	type failure struct{ name, message string }
+	var failures []failure
+	for _, s := range suites {
+		failed := false
+		for _, tc := range s.cases {
+			total++
+			testPause()
+			name := fmt.Sprintf("%s > %s > %s", s.file.path, s.subject, tc.behavior.sentence)
+			ms := int(tc.duration*1000) + 1
+			switch tc.outcome {
+			case outcomeSkip:
+				fmt.Printf(" %s %s\n", yellow("↓"), name)
+			case outcomeFail:
+				failed = true
+				fmt.Printf(" %s %s %dms\n", red("×"), name, ms)
+				failures = append(failures, failure{name, failureMessage(s.subject)})
+			case outcomeFlaky:
+				// --retry 重试通过的用例会标注重试次数
+				fmt.Printf(" %s %s %s %dms\n", green("✓"), name, yellow("(retry x1)"), ms)
+			default:
+				fmt.Printf(" %s %s %dms\n", green("✓"), name, ms)
+			}
+		}
+		if failed {
+			failedSuites++
+		}
+	}
+
+	if len(failures) > 0 {
+		rule := strings.Repeat("⎯", 24)
+		fmt.Printf("\n%s\n", red(fmt.Sprintf("%s Failed Tests %d %s", rule, len(failures), rule)))
+		for _, f := range failures {
+			fmt.Printf("\n %s %s\n", red("FAIL"), f.name)
+			fmt.Println(red("AssertionError: " + f.message))
+		}
+		fmt.Printf("\n%s\n", red(strings.Repeat("⎯", 62)))
+	}
+
+	// 计数为 0 的分类不显示
+	counts := func(failed, passed, skipped int) string {
+		var parts []string
+		if failed > 0 {
+			parts = append(parts, red(fmt.Sprintf("%d failed", failed)))
+		}
+		parts = append(parts, green(fmt.Sprintf("%d passed", passed)))
+		if skipped > 0 {
+			parts = append(parts, yellow(fmt.Sprintf("%d skipped", skipped)))
+		}
+		return strings.Join(parts, " | ")
+	}
+	t := summarizeTests(suites)
+	fmt.Printf("\n Test Files  %s (%d)\n", counts(failedSuites, len(suites)-failedSuites, 0), len(suites))
+	fmt.Printf("      Tests  %s (%d)\n", counts(t.failed, t.passed+t.flaky, t.skipped), total)
+	fmt.Printf("   Start at  %s\n", time.Now().Add(-time.Duration(t.duration*float64(time.Second))).Format("15:04:05"))
+	fmt.Printf("   Duration  %.2fs (transform %dms, setup 0ms, collect %dms, tests %dms, environment %dms, prepare %dms)\n",
+		t.duration+1.2, rand.Intn(300)+120, rand.Intn(600)+200, int(t.duration*1000), rand.Intn(900)+300, rand.Intn(200)+80)
+
+	fmt.Printf("\n %s Coverage report from v8\n", blue("%"))
+	fmt.Println("--------------------------|---------|----------|---------|---------|")
+	fmt.Println("File                      | % Stmts | % Branch | % Funcs | % Lines |")
+	fmt.Println("--------------------------|---------|----------|---------|---------|")
+	for _, s := range suites {
+		name := path.Base(s.file.path)
+		name = strings.TrimSuffix(strings.TrimSuffix(name, ".test.ts"), ".spec.ts")
+		fmt.Printf(" %-24s | %7.2f | %8.2f | %7.2f | %7.2f |\n", name, s.coverage, s.coverage-rand.Float64()*12,
+			s.coverage+rand.Float64()*(100-s.coverage), s.coverage)
+	}
+	fmt.Println("--------------------------|---------|----------|---------|---------|")
+}
+
+func runPytest(config *SessionConfig, suites []testSuite) {
+	fmt.Println("$ pytest -v --reruns 2 --cov=" + config.naming.slug)
+	t := summarizeTests(suites)
+	total := t.passed + t.failed + t.skipped + t.flaky
+	done := 0
+	for _, s := range suites {
+		for _, tc := range s.cases {
+			name := fmt.Sprintf("%s::test_%s_%s", s.file.path, s.subject, tc.behavior.snake)
+			testPause()
+			if tc.outcome == outcomeFlaky || tc.outcome == outcomeFail {
+				fmt.Printf("%s %s\n", name, yellow("RERUN"))
+			}
+			done++
+			progress := fmt.Sprintf("[%3d%%]", done*100/total)
+			switch tc.outcome {
+			case outcomeSkip:
+				fmt.Printf("%s %s %s\n", name, yellow("SKIPPED (requires GPU)"), progress)
+			case outcomeFail:
+				fmt.Printf("%s %s %s\n", name, red("FAILED"), progress)
+			default:
+				fmt.Printf("%s %s %s\n", name, green("PASSED"), progress)
+			}
+		}
+	}
+
+	fmt.Println("\n---------- coverage: platform linux, python 3.12.4-final-0 -----------")
+	fmt.Printf("%-48s %6s %6s %6s\n", "Name", "Stmts", "Miss", "Cover")
+	fmt.Println(strings.Repeat("-", 69))
+	for _, f := range config.repo.sampleFiles(6) {
+		stmts := f.lines * 2 / 3
+		cover := rand.Float64()*40 + 58
+		fmt.Printf("%-48s %6d %6d %5.0f%%\n", f.path, stmts, int(float64(stmts)*(100-cover)/100), cover)
+	}
+	fmt.Println(strings.Repeat("-", 69))
+	fmt.Printf("%-48s %6s %6s %5.0f%%\n", "TOTAL", "", "", t.coverage)
+
+	parts := []string{green(fmt.Sprintf("%d passed", t.passed+t.flaky))}
+	if t.failed > 0 {
+		parts = append([]string{red(fmt.Sprintf("%d failed", t.failed))}, parts...)
+	}
+	if t.skipped > 0 {
+		parts = append(parts, yellow(fmt.Sprintf("%d skipped", t.skipped)))
+	}
+	if t.failed+t.flaky > 0 {
+		parts = append(parts, yellow(fmt.Sprintf("%d rerun", t.failed+t.flaky)))
+	}
+	fmt.Printf("========== %s in %.2fs ==========\n", strings.Join(parts, ", "), t.duration+0.8)
+}
+
+func runGradleTests(suites []testSuite) {
+	fmt.Println("$ ./gradlew test")
+	fmt.Println("> Task :compileTestJava")
+	fmt.Println("> Task :test")
+	for _, s := range suites {
+		fmt.Println()
+		for _, tc := range s.cases {
+			name := fmt.Sprintf("%s > %s()", s.subject+"Test", lowerCamelCase(tc.behavior.snake))
+			testPause()
+			switch tc.outcome {
+			case outcomeSkip:
+				fmt.Printf("%s %s\n", name, yellow("SKIPPED"))
+			case outcomeFail:
+				fmt.Printf("%s %s\n", name, red("FAILED"))
+				fmt.Printf("    org.opentest4j.AssertionFailedError: %s\n", failureMessage(s.subject))
+			case outcomeFlaky:
+				// test-retry 插件会先报告失败，再报告重试结果
+				fmt.Printf("%s %s\n", name, red("FAILED"))
+				fmt.Printf("%s %s\n", name, green("PASSED"))
+			default:
+				fmt.Printf("%s %s\n", name, green("PASSED"))
+			}
+		}
+	}
+	t := summarizeTests(suites)
+	fmt.Println("\n> Task :jacocoTestReport")
+	fmt.Printf("\n%d tests completed, %d failed, %d skipped\n", t.passed+t.failed+t.skipped+t.flaky, t.failed, t.skipped)
+	if t.failed > 0 {
+		fmt.Println(red("\nBUILD FAILED") + fmt.Sprintf(" in %ds", int(t.duration)+6))
+	} else {
+		fmt.Println(green("\nBUILD SUCCESSFUL") + fmt.Sprintf(" in %ds", int(t.duration)+6))
+	}
+}
+
+func runCTest(suites []testSuite) {
+	fmt.Println("$ ctest --test-dir build --output-on-failure --repeat until-pass:2")
+	fmt.Println("Test project build")
+	t := summarizeTests(suites)
+	total := len(suites)
+	failed := 0
+	for i, s := range suites {
+		testPause()
+		result := green("  Passed")
+		for _, tc := range s.cases {
+			if tc.outcome == outcomeFail {
+				result = red("***Failed")
+			}
+		}
+		if strings.Contains(result, "Failed") {
+			failed++
+		}
+		dots := strings.Repeat(".", max(3, 32-len(s.subject+"Tests")))
+		fmt.Printf("%4d/%d Test #%d: %s %s %s %6.2f sec\n", i+1, total, i+1, s.subject+"Tests", dots, result, s.duration)
+	}
+	if total == 0 {
+		fmt.Println("No tests were found!!!")
+		return
+	}
+	fmt.Printf("\n%d%% tests passed, %d tests failed out of %d\n", (total-failed)*100/total, failed, total)
+	fmt.Printf("\nTotal Test time (real) = %6.2f sec\n", t.duration)
+}
@@ -0,0 +1,218 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// 瀑布图中时间轴的字符宽度
+const waterfallWidth = 48
+
+// traceSpan 分布式追踪中的一个 span
+type traceSpan struct {
+	service  string
+	name     string
+	start    time.Duration // 相对根 span 的开始偏移
+	duration time.Duration
+	err      bool
+	attrs    []logField
+	children []*traceSpan
+}
+
+// end 返回 span 的结束偏移
+func (s *traceSpan) end() time.Duration {
+	return s.start + s.duration
+}
+
+// child 在父 span 的剩余时间内按顺序放置一个子 span，返回新 span 和下一个可用的偏移
+func (s *traceSpan) child(cursor time.Duration, share float64, service, name string, attrs ...logField) (*traceSpan, time.Duration) {
+	gap := time.Duration(rand.Intn(400)+50) * time.Microsecond
+	remaining := s.end() - cursor - gap
+	if remaining < time.Microsecond {
+		remaining = time.Microsecond
+	}
+	c := &traceSpan{
+		service:  service,
+		name:     name,
+		start:    cursor + gap,
+		duration: time.Duration(float64(remaining) * min(share*(0.75+rand.Float64()*0.4), 0.97)),
+		attrs:    attrs,
+	}
+	s.children = append(s.children, c)
+	return c, c.end()
+}
+
+// count 返回 span 树中的 span 数和涉及的服务
+func (s *traceSpan) count(services map[string]bool) int {
+	services[s.service] = true
+	n := 1
+	for _, c := range s.children {
+		n += c.count(services)
+	}
+	return n
+}
+
+// dbSpan 为服务生成一个数据库查询 span
+func dbSpan(parent *traceSpan, cursor time.Duration, share float64, naming *ProjectNaming, service string) (*traceSpan, time.Duration) {
+	table := singular(service)
+	if table == service {
+		table += "_records"
+	}
+	op := []string{"SELECT", "SELECT", "INSERT", "UPDATE"}[rand.Intn(4)]
+	stmt := fmt.Sprintf("SELECT * FROM %ss WHERE id = $1", table)
+	switch op {
+	case "INSERT":
+		stmt = fmt.Sprintf("INSERT INTO %ss (id, payload) VALUES ($1, $2)", table)
+	case "UPDATE":
+		stmt = fmt.Sprintf("UPDATE %ss SET updated_at = now() WHERE id = $1", table)
+	}
+	return parent.child(cursor, share, "postgres", op+" "+naming.databaseName(service),
+		logField{"db.system", "postgresql"}, logField{"db.statement", stmt}, logField{"db.rows_affected", rand.Intn(40) + 1})
+}
+
+// buildTrace 根据请求构造一棵 gateway → auth → 业务服务 → 数据库的 span 树
+func buildTrace(req httpRequest, naming *ProjectNaming) *traceSpan {
+	root := &traceSpan{
+		service:  "gateway",
+		name:     req.method + " " + req.route,
+		duration: req.latency,
+		attrs: []logField{
+			{"http.method", req.method},
+			{"http.route", req.route},
+			{"http.target", req.path},
+			{"http.status_code", req.status},
+			{"request_id", req.requestID},
+		},
+	}
+	if req.status >= 500 {
+		root.err = true
+	}
+
+	// 认证：会话缓存加令牌校验
+	auth, cursor := root.child(0, 0.08, "auth", fmt.Sprintf("/%s.auth.v1.AuthService/Verify", naming.pkgName), logField{"rpc.system", "grpc"})
+	auth.child(auth.start, 0.3, "redis", "GET session:{sid}", logField{"db.system", "redis"}, logField{"cache.hit", rand.Intn(4) > 0})
+	if req.status == 401 || req.status == 403 {
+		auth.err = true
+		auth.attrs = append(auth.attrs, logField{"rpc.grpc.status_code", 16}, logField{"error.message", "token rejected"})
+		return root
+	}
+
+	// 业务服务及其依赖
+	service := req.service
+	if service == "gateway" || service == "auth" {
+		service = naming.randomService()
+	}
+	handler, _ := root.child(cursor, 0.92, service, req.method+" "+req.route,
+		logField{"service.namespace", naming.namespace}, logField{"k8s.pod.name", naming.serviceName(service) + "-" + kubeSuffix(10) + "-" + kubeSuffix(5)})
+	cursor = handler.start
+	if rand.Intn(2) == 0 {
+		_, cursor = handler.child(cursor, 0.06, "redis", fmt.Sprintf("GET %s:{id}", singular(service)),
+			logField{"db.system", "redis"}, logField{"cache.hit", false})
+	}
+	_, cursor = dbSpan(handler, cursor, 0.35, naming, service)
+
+	upstream := req.upstream
+	call, _ := handler.child(cursor, 0.85, upstream, naming.grpcMethod(upstream), logField{"rpc.system", "grpc"}, logField{"net.peer.name", naming.serviceName(upstream)})
+	query, _ := dbSpan(call, call.start, 0.7, naming, upstream)
+
+	// 失败时沿着依赖链标记出错的 span
+	if req.status >= 500 {
+		handler.err = true
+		call.err = true
+		call.attrs = append(call.attrs, logField{"error.message", requestError(req)})
+		if req.status == 504 {
+			query.err = true
+			query.attrs = append(query.attrs, logField{"error.message", "canceling statement due to statement timeout"})
+		}
+	}
+	return root
+}
+
+// waterfallBar 按偏移和时长画出 span 在时间轴上的位置
+func waterfallBar(s *traceSpan, total time.Duration) string {
+	from := int(float64(s.start) / float64(total) * waterfallWidth)
+	to := int(float64(s.end()) / float64(total) * waterfallWidth)
+	from = min(from, waterfallWidth-1)
+	to = max(min(to, waterfallWidth), from+1)
+	return strings.Repeat(" ", from) + strings.Repeat("█", to-from) + strings.Repeat(" ", waterfallWidth-to)
+}
+
+// printSpan 递归打印 span 树，prefix 为树形缩进
+func printSpan(s *traceSpan, total time.Duration, prefix, branch string, labelWidth int) {
+	label := prefix + branch + s.service + " " + s.name
+	if n := len([]rune(label)); n > labelWidth {
+		label = string([]rune(label)[:labelWidth-1]) + "…"
+	}
+	pad := labelWidth - len([]rune(label))
+	line := fmt.Sprintf("  %s%s │%s│ %9s %9s", label, strings.Repeat(" ", pad), waterfallBar(s, total), formatSpanDuration(s.start), formatSpanDuration(s.duration))
+	if s.err {
+		line = red(line + " ✗")
+	}
+	fmt.Println(line)
+	time.Sleep(time.Duration(rand.Intn(80)+40) * time.Millisecond)
+
+	childPrefix := prefix
+	switch branch {
+	case "├─ ":
+		childPrefix += "│  "
+	case "└─ ":
+		childPrefix += "   "
+	}
+	for i, c := range s.children {
+		b := "├─ "
+		if i == len(s.children)-1 {
+			b = "└─ "
+		}
+		printSpan(c, total, childPrefix, b, labelWidth)
+	}
+}
+
+// formatSpanDuration 按追踪界面的习惯格式化时长
+func formatSpanDuration(d time.Duration) string {
+	if d < time.Millisecond {
+		return fmt.Sprintf("%dµs", d.Microseconds())
+	}
+	return fmt.Sprintf("%.2fms", float64(d.Microseconds())/1000)
+}
+
+// printSpanAttributes 打印根 span 和出错 span 的属性
+func printSpanAttributes(s *traceSpan) {
+	if s.err || s.service == "gateway" {
+		attrs := make([]string, 0, len(s.attrs))
+		for _, a := range s.attrs {
+			attrs = append(attrs, a.key+"="+logValue(a.value))
+		}
+		fmt.Printf("  %s %s: %s\n", s.service, s.name, strings.Join(attrs, " "))
+	}
+	for _, c := range s.children {
+		printSpanAttributes(c)
+	}
+}
+
+// runTraceWaterfall 以瀑布图展示一次请求的分布式追踪
+func runTraceWaterfall(config *SessionConfig) {
+	req := config.traffic.record(generateMethod(), generateEndpoint(config.devType, config.naming), generateStatus(), config.naming)
+	root := buildTrace(req, config.naming)
+	fmt.Println(blue("🔭 " + tr("trace.title", req.traceID, req.method, req.route)))
+
+	labelWidth := 46
+	fmt.Printf("  %-*s  %-*s %9s %9s\n", labelWidth, "SERVICE / SPAN", waterfallWidth, "0"+strings.Repeat(" ", waterfallWidth-len(formatSpanDuration(root.duration))-1)+formatSpanDuration(root.duration), "START", "DURATION")
+	printSpan(root, root.duration, "", "", labelWidth)
+
+	fmt.Println()
+	printSpanAttributes(root)
+
+	services := map[string]bool{}
+	spans := root.count(services)
+	summary := tr("trace.summary", spans, len(services), formatFloat(float64(root.duration.Microseconds())/1000, 2), req.status)
+	if root.err {
+		fmt.Printf("❌ %s\n", summary)
+	} else {
+		fmt.Printf("✅ %s\n", summary)
+	}
+	if rand.Float32() < jargonDensity(config.jargonLevel) {
+		fmt.Printf("🧠 %s\n", tr("jargon.insight", generateNetworkJargon(config.devType, config.jargonLevel)))
+	}
+}
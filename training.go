@@ -0,0 +1,311 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"path"
+	"strings"
+	"time"
+)
+
+// trainingEpoch 一个 epoch 的训练和验证指标
+type trainingEpoch struct {
+	loss, acc       float64
+	valLoss, valAcc float64
+	lr              float64
+	improved        bool // 验证损失是否创新低
+	reducedLR       bool // 本轮结束后学习率是否因停滞而降低
+}
+
+// trainingRun 一次训练的完整轨迹
+type trainingRun struct {
+	epochs      []trainingEpoch
+	maxEpochs   int
+	best        int // 最佳 epoch 下标
+	earlyStop   bool
+	stepsPerEp  int
+	secsPerStep float64
+}
+
+// 分类任务的标签集合
+var classificationLabels = [][]string{
+	{"legit", "fraud"},
+	{"retained", "churned"},
+	{"negative", "neutral", "positive"},
+	{"low", "medium", "high", "critical"},
+}
+
+// trainingFramework 根据框架配置和开发类型选择训练框架
+func trainingFramework(config *SessionConfig) string {
+	fw := strings.ToLower(config.framework)
+	switch {
+	case strings.Contains(fw, "torch"):
+		return "PyTorch"
+	case strings.Contains(fw, "tensorflow"), strings.Contains(fw, "keras"):
+		return "TensorFlow"
+	case strings.Contains(fw, "jax"), strings.Contains(fw, "flax"):
+		return "JAX"
+	case strings.Contains(fw, "scikit"), strings.Contains(fw, "sklearn"):
+		return "scikit-learn"
+	}
+	if config.devType == DataScience {
+		return "scikit-learn"
+	}
+	return "PyTorch"
+}
+
+// trainingModelName 从虚拟仓库的 models 包中挑一个模型类名
+func trainingModelName(repo *FakeRepo) string {
+	var models []string
+	for _, f := range repo.sourceFiles() {
+		if path.Base(f.dir) == "models" && f.language == "python" && !strings.HasPrefix(path.Base(f.path), "__") {
+			models = append(models, exportedName(strings.TrimSuffix(path.Base(f.path), ".py"))+"Classifier")
+		}
+	}
+	if len(models) == 0 {
+		return "TransformerClassifier"
+	}
+	return models[rand.Intn(len(models))]
+}
+
+// simulateTraining 生成一条收敛的训练曲线，带有平台期、学习率衰减和早停
+func simulateTraining() trainingRun {
+	run := trainingRun{
+		maxEpochs:   rand.Intn(16) + 15,
+		stepsPerEp:  (rand.Intn(12) + 4) * 64,
+		secsPerStep: rand.Float64()*0.05 + 0.02,
+	}
+	const patience = 3
+	floor := rand.Float64()*0.15 + 0.12
+	start := rand.Float64()*0.8 + 1.2
+	rate := rand.Float64()*0.25 + 0.2
+	overfitFrom := run.maxEpochs + 1
+	if rand.Float32() < 0.6 {
+		overfitFrom = rand.Intn(run.maxEpochs/2) + run.maxEpochs/3
+	}
+	lr := []float64{1e-3, 3e-4, 5e-4, 2e-3}[rand.Intn(4)]
+
+	bestVal, stale, plateau := math.Inf(1), 0, 0
+	progress := 0.0
+	for e := 0; e < run.maxEpochs; e++ {
+		// 平台期内几乎没有进展
+		if plateau > 0 {
+			plateau--
+			progress += 0.1
+		} else {
+			progress++
+			if rand.Float32() < 0.12 {
+				plateau = rand.Intn(2) + 2
+			}
+		}
+		loss := floor + (start-floor)*math.Exp(-rate*progress) + rand.Float64()*0.02
+		valLoss := loss*1.05 + 0.02 + rand.Float64()*0.03
+		if e >= overfitFrom {
+			valLoss += 0.015 * float64(e-overfitFrom+1)
+		}
+		ep := trainingEpoch{
+			loss:    loss,
+			acc:     1 - loss*0.45 - rand.Float64()*0.01,
+			valLoss: valLoss,
+			valAcc:  1 - valLoss*0.48 - rand.Float64()*0.01,
+			lr:      lr,
+		}
+		if valLoss < bestVal {
+			bestVal, stale = valLoss, 0
+			ep.improved = true
+			run.best = e
+		} else {
+			stale++
+			// ReduceLROnPlateau：停滞两轮后学习率减半
+			if stale == 2 {
+				lr /= 2
+				ep.reducedLR = true
+			}
+		}
+		run.epochs = append(run.epochs, ep)
+		if stale >= patience {
+			run.earlyStop = true
+			break
+		}
+	}
+	return run
+}
+
+// runModelTraining 模拟一次模型训练
+func runModelTraining(config *SessionConfig) {
+	framework := trainingFramework(config)
+	model := trainingModelName(config.repo)
+	fmt.Println(green("🧠 " + tr("train.title", model, framework)))
+
+	labels := classificationLabels[rand.Intn(len(classificationLabels))]
+	run := simulateTraining()
+	switch framework {
+	case "TensorFlow":
+		trainKeras(run)
+	case "JAX":
+		trainJAX(run)
+	case "scikit-learn":
+		trainSklearn(run)
+	default:
+		trainPyTorch(run, model)
+	}
+
+	best := run.epochs[run.best]
+	printClassificationReport(labels, best.valAcc)
+	if run.earlyStop {
+		fmt.Printf("⏹️  %s\n", tr("train.earlyStop", len(run.epochs), run.best+1))
+	}
+	fmt.Printf("🏁 %s\n", tr("train.summary", formatFloat(best.valLoss, 4), formatPercentFloat(best.valAcc*100, 2), run.best+1))
+	if rand.Float32() < jargonDensity(config.jargonLevel) {
+		fmt.Printf("🧠 %s\n", tr("jargon.insight", generateDataJargon(config.devType, config.jargonLevel)))
+	}
+}
+
+// epochPause 每个 epoch 之间的停顿
+func epochPause() {
+	time.Sleep(time.Duration(rand.Intn(150)+100) * time.Millisecond)
+}
+
+// clockDuration 以 mm:ss 显示耗时，tqdm 的格式
+func clockDuration(seconds float64) string {
+	s := int(seconds)
+	return fmt.Sprintf("%02d:%02d", s/60, s%60)
+}
+
+func trainPyTorch(run trainingRun, model string) {
+	fmt.Printf("$ python -m training.trainer --model %s --max-epochs %d\n", model, run.maxEpochs)
+	fmt.Printf("Using device: cuda:0 (NVIDIA A100-SXM4-80GB), AMP: bf16\n")
+	bar := strings.Repeat("█", 10)
+	for i, ep := range run.epochs {
+		epochPause()
+		secsPerStep := run.secsPerStep * (0.95 + rand.Float64()*0.1)
+		fmt.Printf("Epoch %d/%d: 100%%|%s| %d/%d [%s<00:00, %.2fit/s, loss=%.4f, acc=%.4f, lr=%.1e]\n",
+			i+1, run.maxEpochs, bar, run.stepsPerEp, run.stepsPerEp, clockDuration(float64(run.stepsPerEp)*secsPerStep),
+			1/secsPerStep, ep.loss, ep.acc, ep.lr)
+		line := fmt.Sprintf("  val_loss=%.4f val_acc=%.4f", ep.valLoss, ep.valAcc)
+		if ep.improved {
+			line += green(fmt.Sprintf("  ✓ saved checkpoints/epoch=%02d-val_loss=%.4f.ckpt", i+1, ep.valLoss))
+		}
+		fmt.Println(line)
+		if ep.reducedLR {
+			fmt.Println(yellow(fmt.Sprintf("  ReduceLROnPlateau: reducing learning rate to %.1e", ep.lr/2)))
+		}
+	}
+	if run.earlyStop {
+		fmt.Printf("Early stopping: val_loss did not improve for 3 epochs. Best epoch: %d\n", run.best+1)
+	}
+}
+
+func trainKeras(run trainingRun) {
+	fmt.Println("$ python -m training.trainer --backend tensorflow")
+	prevBest := math.Inf(1)
+	for i, ep := range run.epochs {
+		epochPause()
+		secsPerStep := run.secsPerStep * (0.95 + rand.Float64()*0.1)
+		fmt.Printf("Epoch %d/%d\n", i+1, run.maxEpochs)
+		fmt.Printf("%d/%d [==============================] - %ds %dms/step - loss: %.4f - accuracy: %.4f - val_loss: %.4f - val_accuracy: %.4f - lr: %.4e\n",
+			run.stepsPerEp, run.stepsPerEp, int(float64(run.stepsPerEp)*secsPerStep), int(secsPerStep*1000), ep.loss, ep.acc, ep.valLoss, ep.valAcc, ep.lr)
+		if ep.improved {
+			if math.IsInf(prevBest, 1) {
+				fmt.Println(green(fmt.Sprintf("Epoch %d: val_loss improved from inf to %.5f, saving model to checkpoints/best.keras", i+1, ep.valLoss)))
+			} else {
+				fmt.Println(green(fmt.Sprintf("Epoch %d: val_loss improved from %.5f to %.5f, saving model to checkpoints/best.keras", i+1, prevBest, ep.valLoss)))
+			}
+			prevBest = ep.valLoss
+		} else {
+			fmt.Printf("Epoch %d: val_loss did not improve from %.5f\n", i+1, prevBest)
+		}
+		if ep.reducedLR {
+			fmt.Println(yellow(fmt.Sprintf("Epoch %d: ReduceLROnPlateau reducing learning rate to %.4e.", i+1, ep.lr/2)))
+		}
+	}
+	if run.earlyStop {
+		fmt.Printf("Epoch %d: early stopping\n", len(run.epochs))
+		fmt.Printf("Restoring model weights from the end of the best epoch: %d.\n", run.best+1)
+	}
+}
+
+func trainJAX(run trainingRun) {
+	fmt.Println("$ python -m training.trainer --config configs/flax.yaml")
+	fmt.Println("jax.devices(): [CudaDevice(id=0), CudaDevice(id=1), CudaDevice(id=2), CudaDevice(id=3)]")
+	step := 0
+	for i, ep := range run.epochs {
+		epochPause()
+		step += run.stepsPerEp
+		fmt.Printf("step %6d | epoch %2d | train/loss %.4f | train/acc %.4f | lr %.2e | %.1f steps/s\n",
+			step, i+1, ep.loss, ep.acc, ep.lr, 1/run.secsPerStep)
+		fmt.Printf("eval        | epoch %2d | val/loss   %.4f | val/acc   %.4f\n", i+1, ep.valLoss, ep.valAcc)
+		if ep.improved {
+			fmt.Println(green(fmt.Sprintf("orbax: saved checkpoint for step %d to checkpoints/%d", step, step)))
+		}
+	}
+	if run.earlyStop {
+		fmt.Printf("early stopping triggered at epoch %d (best epoch %d)\n", len(run.epochs), run.best+1)
+	}
+}
+
+func trainSklearn(run trainingRun) {
+	fmt.Println("$ python -m training.trainer --estimator HistGradientBoostingClassifier")
+	candidates := rand.Intn(10) + 6
+	fmt.Printf("Fitting 5 folds for each of %d candidates, totalling %d fits\n", candidates, candidates*5)
+	for c := 0; c < min(candidates, 3); c++ {
+		lr := []float64{0.03, 0.05, 0.1}[c]
+		depth := rand.Intn(6) + 3
+		for fold := 1; fold <= 2; fold++ {
+			time.Sleep(time.Duration(rand.Intn(100)+50) * time.Millisecond)
+			fmt.Printf("[CV %d/5] END learning_rate=%.2f, max_depth=%d;, score=%.3f total time=%5.1fs\n",
+				fold, lr, depth, run.epochs[len(run.epochs)-1].valAcc-rand.Float64()*0.04, rand.Float64()*4+0.5)
+		}
+	}
+	fmt.Println("...")
+
+	// 提升树的迭代日志，每轮相当于一个 epoch
+	fmt.Printf("%10s %16s %16s\n", "Iter", "Train Loss", "Val Loss")
+	for i, ep := range run.epochs {
+		epochPause()
+		fmt.Printf("%10d %16.4f %16.4f\n", (i+1)*10, ep.loss, ep.valLoss)
+	}
+	if run.earlyStop {
+		fmt.Printf("Stopped early after %d iterations (n_iter_no_change=30)\n", len(run.epochs)*10)
+	}
+	fmt.Printf("Model saved to artifacts/model-%s.joblib\n", randomHex(8))
+}
+
+// printClassificationReport 按 sklearn classification_report 的格式打印最终评估
+func printClassificationReport(labels []string, accuracy float64) {
+	fmt.Println()
+	fmt.Printf("%12s %10s %10s %10s %10s\n\n", "", "precision", "recall", "f1-score", "support")
+	support := make([]int, len(labels))
+	total := 0
+	for i := range labels {
+		// 第一个类别通常是多数类
+		support[i] = rand.Intn(800) + 200
+		if i == 0 {
+			support[i] *= 6
+		}
+		total += support[i]
+	}
+
+	var macroP, macroR, macroF, weightP, weightR, weightF float64
+	for i, label := range labels {
+		// 多数类略高于整体准确率，少数类略低
+		offset := func() float64 { return rand.Float64()*0.06 - 0.09 }
+		if i == 0 {
+			offset = func() float64 { return rand.Float64() * 0.02 }
+		}
+		p := math.Min(0.999, accuracy+offset())
+		r := math.Min(0.999, accuracy+offset())
+		f := 2 * p * r / (p + r)
+		fmt.Printf("%12s %10.4f %10.4f %10.4f %10d\n", label, p, r, f, support[i])
+		w := float64(support[i]) / float64(total)
+		macroP, macroR, macroF = macroP+p, macroR+r, macroF+f
+		weightP, weightR, weightF = weightP+p*w, weightR+r*w, weightF+f*w
+	}
+	n := float64(len(labels))
+	fmt.Println()
+	fmt.Printf("%12s %10s %10s %10.4f %10d\n", "accuracy", "", "", accuracy, total)
+	fmt.Printf("%12s %10.4f %10.4f %10.4f %10d\n", "macro avg", macroP/n, macroR/n, macroF/n, total)
+	fmt.Printf("%12s %10.4f %10.4f %10.4f %10d\n", "weighted avg", weightP, weightR, weightF, total)
+	fmt.Println()
+}